@@ -0,0 +1,254 @@
+/*
+Captions and subtitles for audio and video played with these bindings.
+
+A Track is loaded from a SubRip (.srt) or WebVTT (.vtt) file and rendered
+with a TTF font by a Renderer, given the current playback time; for music
+played with package mixer that is the time the music was started from plus
+the time elapsed since.
+*/
+package captions
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A caption shown from Start until End.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Lines []string
+}
+
+// A list of cues sorted by start time.
+type Track struct {
+	Cues []Cue
+}
+
+// Returned when a caption file cannot be parsed.
+var ErrFormat = errors.New("captions: unrecognized caption format")
+
+// Loads a caption track, choosing the format by the file extension.
+// Files with an unknown extension are parsed as WebVTT if they start with
+// the WEBVTT signature and as SubRip otherwise.
+func Load(file string) (*Track, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".srt":
+		return ParseSRT(f)
+	case ".vtt":
+		return ParseVTT(f)
+	}
+
+	return Parse(f)
+}
+
+// Parses a SubRip or WebVTT track, detecting the format from the content.
+func Parse(r io.Reader) (*Track, error) {
+	blocks, err := readBlocks(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocks) > 0 && isVTTHeader(blocks[0][0]) {
+		return parseBlocks(blocks[1:], true)
+	}
+
+	return parseBlocks(blocks, false)
+}
+
+// Parses a SubRip track.
+func ParseSRT(r io.Reader) (*Track, error) {
+	blocks, err := readBlocks(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlocks(blocks, false)
+}
+
+// Parses a WebVTT track. Cue settings, NOTE, STYLE and REGION blocks are
+// ignored.
+func ParseVTT(r io.Reader) (*Track, error) {
+	blocks, err := readBlocks(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocks) == 0 || !isVTTHeader(blocks[0][0]) {
+		return nil, ErrFormat
+	}
+
+	return parseBlocks(blocks[1:], true)
+}
+
+// Returns the cues showing at time t, in start order. Usually there is at
+// most one, but cues may overlap.
+func (t *Track) Active(at time.Duration) []Cue {
+	// Cues starting after t can be skipped
+	n := sort.Search(len(t.Cues), func(i int) bool { return t.Cues[i].Start > at })
+
+	var active []Cue
+	for _, cue := range t.Cues[:n] {
+		if at < cue.End {
+			active = append(active, cue)
+		}
+	}
+
+	return active
+}
+
+func isVTTHeader(line string) bool {
+	line = strings.TrimPrefix(line, "\ufeff")
+	return line == "WEBVTT" || strings.HasPrefix(line, "WEBVTT ") || strings.HasPrefix(line, "WEBVTT\t")
+}
+
+// Splits the input into blocks of non-empty lines.
+func readBlocks(r io.Reader) ([][]string, error) {
+	var blocks [][]string
+	var block []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			if block != nil {
+				blocks = append(blocks, block)
+				block = nil
+			}
+			continue
+		}
+		block = append(block, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if block != nil {
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func parseBlocks(blocks [][]string, vtt bool) (*Track, error) {
+	track := &Track{}
+
+	for _, block := range blocks {
+		if vtt && (strings.HasPrefix(block[0], "NOTE") ||
+			block[0] == "STYLE" || block[0] == "REGION") {
+			continue
+		}
+
+		// The cue number (SubRip) or identifier (WebVTT) is optional
+		timing := 0
+		if !strings.Contains(block[0], "-->") {
+			timing = 1
+		}
+		if timing >= len(block) || !strings.Contains(block[timing], "-->") {
+			return nil, fmt.Errorf("captions: missing cue timing in %q", block[0])
+		}
+
+		start, end, err := parseTiming(block[timing])
+		if err != nil {
+			return nil, err
+		}
+
+		cue := Cue{Start: start, End: end}
+		for _, line := range block[timing+1:] {
+			cue.Lines = append(cue.Lines, stripTags(line))
+		}
+
+		track.Cues = append(track.Cues, cue)
+	}
+
+	sort.SliceStable(track.Cues, func(i, j int) bool {
+		return track.Cues[i].Start < track.Cues[j].Start
+	})
+
+	return track, nil
+}
+
+// Parses "start --> end", followed by WebVTT cue settings or SubRip
+// coordinates, which are ignored.
+func parseTiming(line string) (start, end time.Duration, err error) {
+	parts := strings.SplitN(line, "-->", 2)
+
+	fields := strings.Fields(parts[1])
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("captions: invalid cue timing %q", line)
+	}
+
+	if start, err = parseTimestamp(strings.TrimSpace(parts[0])); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseTimestamp(fields[0]); err != nil {
+		return 0, 0, err
+	}
+
+	return start, end, nil
+}
+
+// Parses hh:mm:ss,mmm (SubRip) and [hh:]mm:ss.mmm (WebVTT) timestamps.
+func parseTimestamp(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("captions: invalid timestamp %q", s)
+
+	s = strings.Replace(s, ",", ".", 1)
+
+	fraction := "0"
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, fraction = s[:i], s[i+1:]
+	}
+
+	fields := strings.Split(s, ":")
+	if len(fields) < 2 || len(fields) > 3 {
+		return 0, invalid
+	}
+
+	var d time.Duration
+	for _, field := range fields {
+		v, err := strconv.Atoi(field)
+		if err != nil || v < 0 {
+			return 0, invalid
+		}
+		d = d*60 + time.Duration(v)
+	}
+	d *= time.Second
+
+	// Only millisecond precision is meaningful
+	for len(fraction) < 3 {
+		fraction += "0"
+	}
+	ms, err := strconv.Atoi(fraction[:3])
+	if err != nil {
+		return 0, invalid
+	}
+
+	return d + time.Duration(ms)*time.Millisecond, nil
+}
+
+var tagPattern = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+
+var entityReplacer = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">",
+	"&nbsp;", " ", "&lrm;", "\u200e", "&rlm;", "\u200f")
+
+// Removes formatting tags (<i>, <b>, <c.class>, <v Speaker>, {\an8}, ...)
+// and decodes the character references WebVTT allows.
+func stripTags(line string) string {
+	return entityReplacer.Replace(tagPattern.ReplaceAllString(line, ""))
+}
@@ -0,0 +1,138 @@
+package captions
+
+import (
+	"github.com/scottferg/Go-SDL2/sdl"
+	"github.com/scottferg/Go-SDL2/ttf"
+	"strings"
+	"time"
+)
+
+// Draws the active cues of a track near the bottom of the rendering target.
+//
+// Every line is rendered once into a texture when its cue becomes active
+// and the textures are reused until the active cues change.
+type Renderer struct {
+	Track *Track
+	Font  *ttf.Font
+	Color sdl.Color
+
+	// Background drawn behind each line, skipped if fully transparent.
+	Background sdl.Color
+
+	// Space between the lowest line and the bottom of the target, and the
+	// padding around each line's background, in pixels.
+	Margin  int
+	Padding int
+
+	renderer *sdl.Renderer
+	key      string
+	lines    []line
+}
+
+type line struct {
+	texture *sdl.Texture
+	w, h    int
+}
+
+// Creates a caption renderer drawing white text on a translucent black
+// background.
+func NewRenderer(r *sdl.Renderer, track *Track, font *ttf.Font) *Renderer {
+	return &Renderer{
+		Track:      track,
+		Font:       font,
+		Color:      sdl.Color{R: 255, G: 255, B: 255, Alpha: 255},
+		Background: sdl.Color{Alpha: 160},
+		Margin:     24,
+		Padding:    4,
+		renderer:   r,
+	}
+}
+
+// Draws the cues active at the playback time t, centered horizontally in
+// a target of the given width and height. The renderer's draw color and
+// blend mode are left as they were.
+func (c *Renderer) Draw(t time.Duration, width, height int) {
+	c.update(c.Track.Active(t))
+
+	if c.Background.Alpha != 0 && len(c.lines) > 0 {
+		// Don't leak the background's settings into the caller's drawing
+		mode, color := c.renderer.GetDrawBlendMode(), c.renderer.GetDrawColor()
+		defer func() {
+			c.renderer.SetDrawBlendMode(mode)
+			c.renderer.SetDrawColor(color)
+		}()
+	}
+
+	y := height - c.Margin
+	for i := len(c.lines) - 1; i >= 0; i-- {
+		l := c.lines[i]
+		y -= l.h + 2*c.Padding
+
+		x := (width - l.w) / 2
+
+		if c.Background.Alpha != 0 {
+			c.renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+			c.renderer.SetDrawColor(c.Background)
+			c.renderer.FillRect(&sdl.Rect{
+				X: int32(x - c.Padding),
+				Y: int32(y),
+				W: int32(l.w + 2*c.Padding),
+				H: int32(l.h + 2*c.Padding),
+			})
+		}
+
+		c.renderer.Copy(l.texture, nil, &sdl.Rect{
+			X: int32(x),
+			Y: int32(y + c.Padding),
+			W: int32(l.w),
+			H: int32(l.h),
+		})
+	}
+}
+
+// Renders the lines of the cues, unless they are already rendered.
+func (c *Renderer) update(cues []Cue) {
+	var text []string
+	for _, cue := range cues {
+		text = append(text, cue.Lines...)
+	}
+
+	key := strings.Join(text, "\n")
+	if key == c.key && c.lines != nil {
+		return
+	}
+
+	c.Destroy()
+	c.key = key
+
+	c.lines = make([]line, 0, len(text))
+	for _, s := range text {
+		if s == "" {
+			continue
+		}
+
+		surface := ttf.RenderUTF8_Blended(c.Font, s, c.Color)
+		if surface == nil {
+			continue
+		}
+
+		texture := sdl.CreateTextureFromSurface(c.renderer, surface)
+		w, h := int(surface.W), int(surface.H)
+		surface.Free()
+
+		if texture != nil {
+			c.lines = append(c.lines, line{texture, w, h})
+		}
+	}
+}
+
+// Frees the textures of the rendered lines. The renderer can still be
+// used afterwards.
+func (c *Renderer) Destroy() {
+	for _, l := range c.lines {
+		l.texture.Destroy()
+	}
+
+	c.lines = nil
+	c.key = ""
+}
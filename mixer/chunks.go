@@ -1,6 +1,5 @@
 package mixer
 
-// #cgo pkg-config: SDL2_mixer
 // #include <SDL2/SDL_mixer.h>
 import "C"
 import "unsafe"
@@ -0,0 +1,9 @@
+//go:build !sdl_static
+
+package mixer
+
+// Links against the shared SDL2_mixer found through pkg-config.
+// Build with the sdl_static tag to link them statically instead.
+
+// #cgo pkg-config: SDL2_mixer
+import "C"
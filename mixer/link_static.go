@@ -0,0 +1,9 @@
+//go:build sdl_static
+
+package mixer
+
+// Links SDL2_mixer statically; see sdl/link_static.go.
+
+// #cgo linux LDFLAGS: -Wl,-Bstatic -lSDL2_mixer -lSDL2 -Wl,-Bdynamic -Wl,--as-needed
+// #cgo pkg-config: --static SDL2_mixer
+import "C"
@@ -7,7 +7,6 @@ functions have been changed to be in a more object-oriented style
 */
 package mixer
 
-// #cgo pkg-config: SDL2_mixer
 // #include <SDL2/SDL_mixer.h>
 import "C"
 import "unsafe"
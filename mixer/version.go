@@ -0,0 +1,16 @@
+package mixer
+
+// #include <SDL2/SDL_mixer.h>
+import "C"
+import "github.com/scottferg/Go-SDL2/sdl"
+
+func init() {
+	v := C.Mix_Linked_Version()
+
+	sdl.RegisterLibrary(sdl.LibraryInfo{
+		Name:     "SDL2_mixer",
+		Linked:   true,
+		Compiled: sdl.Version{C.SDL_MIXER_MAJOR_VERSION, C.SDL_MIXER_MINOR_VERSION, C.SDL_MIXER_PATCHLEVEL},
+		Runtime:  sdl.Version{uint8(v.major), uint8(v.minor), uint8(v.patch)},
+	})
+}
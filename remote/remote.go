@@ -0,0 +1,254 @@
+/*
+Remote control of applications built on these bindings.
+
+A Bridge listens on a local socket for JSON-encoded input events and
+injects them into the SDL event queue with sdl.PushEvent, where they are
+indistinguishable from real input. This allows automated QA scripts to
+drive a running game and accessibility switch devices to be connected
+through a small helper program.
+
+Each connection carries a stream of JSON objects, one per event:
+
+	{"type": "keydown", "key": 13}
+	{"type": "keyup", "key": 13}
+	{"type": "mousemotion", "x": 100, "y": 80}
+	{"type": "mousebuttondown", "button": 1, "x": 100, "y": 80}
+	{"type": "mousebuttonup", "button": 1, "x": 100, "y": 80}
+	{"type": "mousewheel", "x": 0, "y": -1}
+	{"type": "controllerbuttondown", "which": 0, "button": 0}
+	{"type": "controllerbuttonup", "which": 0, "button": 0}
+	{"type": "controlleraxismotion", "which": 0, "axis": 1, "value": -32768}
+
+"key" is an SDL keycode (one of the sdl.K_* values) and "window" may be
+given to address a window other than the one with keyboard or mouse focus.
+The bridge answers every event with {"ok": true} or {"ok": false,
+"error": "..."}.
+
+The bridge is not compiled into programs that don't import this package.
+*/
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/scottferg/Go-SDL2/sdl"
+	"io"
+	"net"
+	"runtime"
+	"sync"
+)
+
+// An input event received from a remote client.
+type Message struct {
+	Type   string `json:"type"`
+	Window uint32 `json:"window"`
+
+	Key uint32 `json:"key"`
+	Mod uint32 `json:"mod"`
+
+	X      int32 `json:"x"`
+	Y      int32 `json:"y"`
+	Button uint8 `json:"button"`
+
+	Which int32 `json:"which"`
+	Axis  uint8 `json:"axis"`
+	Value int16 `json:"value"`
+}
+
+type reply struct {
+	Ok    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// Returned by Listen for addresses that are reachable from other hosts.
+var ErrNotLocal = errors.New("remote: refusing to listen on a non-loopback address")
+
+// Accepts remote control connections and injects the events they send.
+type Bridge struct {
+	listener net.Listener
+
+	mutex sync.Mutex
+	conns map[net.Conn]bool
+	done  sync.WaitGroup
+}
+
+// Starts a bridge listening on a Unix socket (network "unix") or a
+// loopback TCP address (network "tcp", e.g. "127.0.0.1:7777").
+func Listen(network, address string) (*Bridge, error) {
+	if network != "unix" {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return nil, ErrNotLocal
+		}
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bridge{listener: listener, conns: make(map[net.Conn]bool)}
+
+	b.done.Add(1)
+	go b.accept()
+
+	return b, nil
+}
+
+// Returns the address the bridge is listening on.
+func (b *Bridge) Addr() net.Addr {
+	return b.listener.Addr()
+}
+
+// Stops listening, closes all connections and waits for them to finish.
+func (b *Bridge) Close() error {
+	err := b.listener.Close()
+
+	b.mutex.Lock()
+	for conn := range b.conns {
+		conn.Close()
+	}
+	b.mutex.Unlock()
+
+	b.done.Wait()
+	return err
+}
+
+func (b *Bridge) accept() {
+	defer b.done.Done()
+
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		b.mutex.Lock()
+		b.conns[conn] = true
+		b.mutex.Unlock()
+
+		b.done.Add(1)
+		go b.serve(conn)
+	}
+}
+
+func (b *Bridge) serve(conn net.Conn) {
+	defer b.done.Done()
+	defer func() {
+		b.mutex.Lock()
+		delete(b.conns, conn)
+		b.mutex.Unlock()
+
+		conn.Close()
+	}()
+
+	decoder := json.NewDecoder(conn)
+	encoder := json.NewEncoder(conn)
+
+	for {
+		var m Message
+		if err := decoder.Decode(&m); err != nil {
+			if err != io.EOF {
+				encoder.Encode(reply{Error: err.Error()})
+			}
+			return
+		}
+
+		r := reply{Ok: true}
+		if err := Inject(&m); err != nil {
+			r = reply{Error: err.Error()}
+		}
+
+		if encoder.Encode(r) != nil {
+			return
+		}
+	}
+}
+
+// Pushes the SDL event described by a message onto the event queue.
+func Inject(m *Message) error {
+	// SDL keeps the error message per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var status int
+
+	switch m.Type {
+	case "keydown", "keyup":
+		e := sdl.KeyboardEvent{Type: sdl.KEYDOWN, WindowId: m.Window, State: sdl.PRESSED}
+		if m.Type == "keyup" {
+			e.Type, e.State = sdl.KEYUP, sdl.RELEASED
+		}
+		e.Keysym.Sym = m.Key
+		e.Keysym.Mod = uint16(m.Mod)
+		status = sdl.PushEvent(&e)
+
+	case "mousemotion":
+		e := sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, WindowId: m.Window, X: m.X, Y: m.Y}
+		status = sdl.PushEvent(&e)
+
+	case "mousebuttondown", "mousebuttonup":
+		e := sdl.MouseButtonEvent{
+			Type:     sdl.MOUSEBUTTONDOWN,
+			WindowId: m.Window,
+			Button:   m.Button,
+			State:    sdl.PRESSED,
+			Clicks:   1,
+			X:        m.X,
+			Y:        m.Y,
+		}
+		if m.Type == "mousebuttonup" {
+			e.Type, e.State = sdl.MOUSEBUTTONUP, sdl.RELEASED
+		}
+		status = sdl.PushEvent(&e)
+
+	case "mousewheel":
+		e := sdl.MouseWheelEvent{
+			Type:      sdl.MOUSEWHEEL,
+			WindowId:  m.Window,
+			X:         m.X,
+			Y:         m.Y,
+			Direction: sdl.MOUSEWHEEL_NORMAL,
+			PreciseX:  float32(m.X),
+			PreciseY:  float32(m.Y),
+		}
+		status = sdl.PushEvent(&e)
+
+	case "controllerbuttondown", "controllerbuttonup":
+		e := sdl.ControllerButtonEvent{
+			Type:   sdl.CONTROLLERBUTTONDOWN,
+			Which:  m.Which,
+			Button: m.Button,
+			State:  sdl.PRESSED,
+		}
+		if m.Type == "controllerbuttonup" {
+			e.Type, e.State = sdl.CONTROLLERBUTTONUP, sdl.RELEASED
+		}
+		status = sdl.PushEvent(&e)
+
+	case "controlleraxismotion":
+		e := sdl.ControllerAxisEvent{
+			Type:  sdl.CONTROLLERAXISMOTION,
+			Which: m.Which,
+			Axis:  m.Axis,
+			Value: m.Value,
+		}
+		status = sdl.PushEvent(&e)
+
+	default:
+		return fmt.Errorf("remote: unknown event type %q", m.Type)
+	}
+
+	if status < 0 {
+		return errors.New(sdl.GetError())
+	}
+	if status == 0 {
+		return errors.New("remote: event was filtered")
+	}
+
+	return nil
+}
@@ -90,7 +90,7 @@ func main() {
 
 	// ticker := time.NewTicker(time.Second / 50) // 50 Hz
 
-	rend.SetDrawColor(sdl.Color{0x30, 0x20, 0x19, 0xFF, 0x00})
+	rend.SetDrawColor(sdl.Color{0x30, 0x20, 0x19, 0xFF})
 	rend.FillRect(nil)
 	rend.Copy(tex, nil, nil)
 	rend.Present()
@@ -112,7 +112,7 @@ func main() {
 							select {
 							case p := <-draw:
 								rend.Clear()
-								rend.Copy(tex, &sdl.Rect{int16(p.x), int16(p.y), 0, 0}, nil)
+								rend.Copy(tex, &sdl.Rect{int32(p.x), int32(p.y), 0, 0}, nil)
 
 							case <-out:
 							default:
@@ -146,7 +146,7 @@ func main() {
 				println()
 
 				fmt.Printf("Type: %02x State: %02x Pad: %02x\n", e.Type, e.State, e.Pad0[0])
-				fmt.Printf("Scancode: %02x Sym: %08x Mod: %04x Unicode: %04x\n", e.Keysym.Scancode, e.Keysym.Sym, e.Keysym.Mod, e.Keysym.Unicode)
+				fmt.Printf("Scancode: %02x Sym: %08x Mod: %04x\n", e.Keysym.Scancode, e.Keysym.Sym, e.Keysym.Mod)
 
 			case sdl.MouseButtonEvent:
 				if e.Type == sdl.MOUSEBUTTONDOWN {
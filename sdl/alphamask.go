@@ -0,0 +1,131 @@
+package sdl
+
+import "math"
+
+// A one bit per pixel record of which pixels of an image are opaque,
+// used for pixel-perfect hit testing of sprites drawn from textures.
+//
+// To save memory the mask can be downsampled: with a scale of 2, each
+// mask bit covers a 2x2 block of the image and is set if any pixel in the
+// block is opaque.
+type AlphaMask struct {
+	W int // Width of the source image
+	H int // Height of the source image
+
+	scale  int
+	mw, mh int
+	bits   []uint64
+}
+
+// Builds an alpha mask from a surface. Pixels whose alpha is at least
+// threshold are considered opaque; surfaces without an alpha channel are
+// opaque everywhere. scale is the downsampling factor, 1 keeps every pixel.
+// Typically this is called on the surface just before it is turned into a
+// texture with CreateTextureFromSurface.
+func NewAlphaMask(s *Surface, scale int, threshold uint8) *AlphaMask {
+	if scale < 1 {
+		scale = 1
+	}
+
+	m := &AlphaMask{
+		W:     int(s.W),
+		H:     int(s.H),
+		scale: scale,
+		mw:    (int(s.W) + scale - 1) / scale,
+		mh:    (int(s.H) + scale - 1) / scale,
+	}
+	m.bits = make([]uint64, (m.mw*m.mh+63)/64)
+
+	s.Lock()
+	defer s.Unlock()
+
+	format := s.Format
+	if format.Amask == 0 {
+		for i := range m.bits {
+			m.bits[i] = ^uint64(0)
+		}
+		return m
+	}
+
+	bpp := int(format.BytesPerPixel)
+	pixels := s.pixelBytes()
+
+	for y := 0; y < m.H; y++ {
+		row := pixels[y*int(s.Pitch):]
+		for x := 0; x < m.W; x++ {
+			pixel := readPixel(row[x*bpp:], bpp)
+			alpha := ((pixel & format.Amask) >> format.Ashift) << format.Aloss
+			if uint8(alpha) >= threshold {
+				m.set(x/scale, y/scale)
+			}
+		}
+	}
+
+	return m
+}
+
+func (m *AlphaMask) set(mx, my int) {
+	i := my*m.mw + mx
+	m.bits[i/64] |= 1 << uint(i%64)
+}
+
+// Checks whether the image pixel x,y is opaque. Points outside the image
+// are never opaque.
+func (m *AlphaMask) Opaque(x, y int) bool {
+	if x < 0 || y < 0 || x >= m.W || y >= m.H {
+		return false
+	}
+
+	i := (y/m.scale)*m.mw + x/m.scale
+	return m.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Checks whether the point px,py in render coordinates lies over an
+// opaque pixel of a sprite drawn with
+//
+//	renderer.CopyEx(texture, src, dst, angle, center, flip)
+//
+// using the same arguments. src may be nil for the whole image and center
+// may be nil for the center of dst, as with CopyEx; dst must not be nil.
+// Plain Copy calls correspond to an angle of 0 and FLIP_NONE.
+func (m *AlphaMask) HitTest(px, py int, src, dst *Rect, angle float64, center *Point, flip int) bool {
+	if dst == nil || dst.W <= 0 || dst.H <= 0 {
+		return false
+	}
+
+	cx, cy := float64(dst.W)/2, float64(dst.H)/2
+	if center != nil {
+		cx, cy = float64(center.X), float64(center.Y)
+	}
+
+	// Undo the rotation around the center point
+	qx := float64(px) + 0.5 - float64(dst.X) - cx
+	qy := float64(py) + 0.5 - float64(dst.Y) - cy
+
+	sin, cos := math.Sincos(angle * math.Pi / 180)
+	lx := qx*cos + qy*sin + cx
+	ly := -qx*sin + qy*cos + cy
+
+	w, h := float64(dst.W), float64(dst.H)
+	if lx < 0 || ly < 0 || lx >= w || ly >= h {
+		return false
+	}
+
+	if flip&FLIP_HORIZONTAL != 0 {
+		lx = w - lx
+	}
+	if flip&FLIP_VERTICAL != 0 {
+		ly = h - ly
+	}
+
+	sx, sy, sw, sh := 0.0, 0.0, float64(m.W), float64(m.H)
+	if src != nil {
+		sx, sy, sw, sh = float64(src.X), float64(src.Y), float64(src.W), float64(src.H)
+	}
+
+	// Clamp, a flipped edge maps exactly onto the end of the source rect
+	x := int(math.Min(math.Floor(sx+lx*sw/w), sx+sw-1))
+	y := int(math.Min(math.Floor(sy+ly*sh/h), sy+sh-1))
+
+	return m.Opaque(x, y)
+}
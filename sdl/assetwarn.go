@@ -0,0 +1,110 @@
+package sdl
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Kinds of asset warnings.
+const (
+	// The image's pixel format is not supported by the renderer, so its
+	// pixels are converted every time it is uploaded.
+	ASSET_FORMAT_CONVERSION = iota
+
+	// The image's size is not a power of two, which the renderer may not
+	// support natively and may pad or emulate.
+	ASSET_NON_POWER_OF_TWO
+
+	// The image is larger than the largest texture the renderer supports,
+	// so creating the texture fails.
+	ASSET_OVERSIZED
+)
+
+// Describes a costly or failing texture upload, reported when a texture
+// is created from a surface or image.
+type AssetWarning struct {
+	Kind    int // One of the ASSET_* constants
+	Message string
+
+	W, H   int    // Size of the image
+	Format uint32 // PIXELFORMAT_* of the image
+
+	Renderer string // Name of the rendering driver
+}
+
+var assetWarningMutex sync.Mutex
+var assetWarningHandler = logAssetWarning
+
+func logAssetWarning(w AssetWarning) {
+	LogMessage(LOG_CATEGORY_RENDER, LOG_PRIORITY_WARN, w.Message)
+}
+
+// Sets the function receiving asset warnings. By default they are logged
+// with LogMessage in LOG_CATEGORY_RENDER at LOG_PRIORITY_WARN. Passing nil
+// turns the checks off.
+//
+// The handler may be called from any goroutine creating textures.
+func SetAssetWarningHandler(handler func(w AssetWarning)) {
+	assetWarningMutex.Lock()
+	defer assetWarningMutex.Unlock()
+
+	assetWarningHandler = handler
+}
+
+// Rendering drivers which need power of two texture sizes for good
+// performance or at all.
+var powerOfTwoDrivers = map[string]bool{
+	"opengles": true, // OpenGL ES 1.x
+}
+
+// Checks an image about to be turned into a texture for the renderer and
+// reports what will be costly about it. Must be called without
+// GlobalMutex held.
+func checkAsset(r *Renderer, format uint32, w, h int) {
+	assetWarningMutex.Lock()
+	handler := assetWarningHandler
+	assetWarningMutex.Unlock()
+
+	if handler == nil {
+		return
+	}
+
+	info, err := r.GetInfo()
+	if err != nil {
+		return
+	}
+
+	warn := func(kind int, message string) {
+		handler(AssetWarning{
+			Kind:     kind,
+			Message:  fmt.Sprintf("%dx%d %s image: %s", w, h, pixelFormatName(format), message),
+			W:        w,
+			H:        h,
+			Format:   format,
+			Renderer: info.Name,
+		})
+	}
+
+	if (info.MaxTextureWidth > 0 && w > info.MaxTextureWidth) ||
+		(info.MaxTextureHeight > 0 && h > info.MaxTextureHeight) {
+		warn(ASSET_OVERSIZED, fmt.Sprintf("larger than the %dx%d maximum texture size of the %s renderer",
+			info.MaxTextureWidth, info.MaxTextureHeight, info.Name))
+	}
+
+	supported := false
+	for _, f := range info.TextureFormats {
+		if f == format {
+			supported = true
+			break
+		}
+	}
+	if !supported && len(info.TextureFormats) > 0 {
+		warn(ASSET_FORMAT_CONVERSION, fmt.Sprintf("format not supported by the %s renderer, converting to %s",
+			info.Name, pixelFormatName(info.TextureFormats[0])))
+	}
+
+	if powerOfTwoDrivers[info.Name] && (w&(w-1) != 0 || h&(h-1) != 0) {
+		warn(ASSET_NON_POWER_OF_TWO, fmt.Sprintf("size is not a power of two, which the %s renderer handles poorly",
+			info.Name))
+	}
+}
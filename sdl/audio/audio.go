@@ -10,7 +10,6 @@ An interface to low-level SDL2 sound functions.
 */
 package audio
 
-// #cgo pkg-config: sdl2
 // #cgo freebsd LDFLAGS: -lrt
 // #cgo linux LDFLAGS: -lrt
 // #cgo windows LDFLAGS: -lpthread
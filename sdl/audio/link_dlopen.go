@@ -0,0 +1,8 @@
+//go:build sdl_dlopen && linux && !sdl_static
+
+package audio
+
+// Uses the SDL2 loaded at run time by the sdl package, which also defines
+// the SDL functions this package calls.
+
+import _ "github.com/scottferg/Go-SDL2/sdl"
@@ -0,0 +1,9 @@
+//go:build sdl_static
+
+package audio
+
+// Links SDL2 statically; see sdl/link_static.go.
+
+// #cgo linux LDFLAGS: -Wl,-Bstatic -lSDL2 -Wl,-Bdynamic -Wl,--as-needed
+// #cgo pkg-config: --static sdl2
+import "C"
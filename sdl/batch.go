@@ -0,0 +1,92 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+//
+// enum {
+//	COPY_SRC    = 1,
+//	COPY_DST    = 2,
+//	COPY_CENTER = 4,
+//	COPY_EX     = 8
+// };
+//
+// typedef struct {
+//	SDL_Texture *texture;
+//	SDL_Rect src, dst;
+//	SDL_Point center;
+//	double angle;
+//	int flip;
+//	int flags;
+// } copyCmd;
+//
+// static int copyBatch(SDL_Renderer *renderer, const copyCmd *cmds, int n) {
+//	int i, status;
+//	for (i = 0; i < n; i++) {
+//		const copyCmd *c = &cmds[i];
+//		const SDL_Rect *src = (c->flags & COPY_SRC) ? &c->src : NULL;
+//		const SDL_Rect *dst = (c->flags & COPY_DST) ? &c->dst : NULL;
+//		if (c->flags & COPY_EX) {
+//			const SDL_Point *center = (c->flags & COPY_CENTER) ? &c->center : NULL;
+//			status = SDL_RenderCopyEx(renderer, c->texture, src, dst, c->angle, center, c->flip);
+//		} else {
+//			status = SDL_RenderCopy(renderer, c->texture, src, dst);
+//		}
+//		if (status != 0) {
+//			return status;
+//		}
+//	}
+//	return 0;
+// }
+import "C"
+
+// The copies of a CopyBatch call, in the layout of the C side.
+type copyBuffer []C.copyCmd
+
+// A texture copy for Renderer.CopyBatch. Src and Dst work as in Copy; if
+// Angle, Center or Flip is set the copy works as in CopyEx.
+type CopyCmd struct {
+	Texture  *Texture
+	Src, Dst *Rect
+	Angle    float64
+	Center   *Point
+	Flip     int
+}
+
+// Copies many textures to the rendering target in a single call into
+// SDL, which is much cheaper than calling Copy for each of them when
+// drawing thousands of sprites. The copies are done in order and stop at
+// the first that fails. Returns 0 on success or a negative error code.
+func (r *Renderer) CopyBatch(cmds []CopyCmd) int {
+	if len(cmds) == 0 {
+		return 0
+	}
+
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	// The buffer is kept with the renderer to avoid allocating every frame
+	batch := r.shared.batch[:0]
+	for _, cmd := range cmds {
+		c := C.copyCmd{texture: cmd.Texture.handle()}
+		if cmd.Src != nil {
+			c.src = *(*C.SDL_Rect)(cast(cmd.Src))
+			c.flags |= C.COPY_SRC
+		}
+		if cmd.Dst != nil {
+			c.dst = *(*C.SDL_Rect)(cast(cmd.Dst))
+			c.flags |= C.COPY_DST
+		}
+		if cmd.Center != nil {
+			c.center = *(*C.SDL_Point)(cast(cmd.Center))
+			c.flags |= C.COPY_CENTER | C.COPY_EX
+		}
+		if cmd.Angle != 0 || cmd.Flip != 0 {
+			c.angle = C.double(cmd.Angle)
+			c.flip = C.int(cmd.Flip)
+			c.flags |= C.COPY_EX
+		}
+		batch = append(batch, c)
+	}
+	r.shared.batch = batch
+
+	return int(C.copyBatch(r.cRenderer, &batch[0], C.int(len(batch))))
+}
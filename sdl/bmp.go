@@ -0,0 +1,184 @@
+package sdl
+
+// #include "callbacks.h"
+import "C"
+
+import (
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// Loads a BMP image file into a new surface. Unlike Load this doesn't
+// need SDL_image. Returns nil on error.
+func LoadBMP(file string) *Surface {
+	cfile := C.CString(file)
+	defer C.free(unsafe.Pointer(cfile))
+
+	cmode := C.CString("rb")
+	defer C.free(unsafe.Pointer(cmode))
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	// Closes the file
+	return wrapSurface(C.SDL_LoadBMP_RW(C.SDL_RWFromFile(cfile, cmode), 1))
+}
+
+// Reads a BMP image into a new surface.
+func LoadBMP_RW(r io.Reader) (*Surface, error) {
+	defer lockThread()()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	rw := C.SDL_RWFromConstMem(unsafe.Pointer(&data[0]), C.int(len(data)))
+	if rw == nil {
+		return nil, sdlError()
+	}
+
+	// Closes rw
+	s := wrapSurface(C.SDL_LoadBMP_RW(rw, 1))
+	if s == nil {
+		return nil, sdlError()
+	}
+
+	return s, nil
+}
+
+// Saves the surface to a BMP image file.
+// Returns 0 on success or a negative error code.
+func (s *Surface) SaveBMP(file string) int {
+	s.check()
+
+	cfile := C.CString(file)
+	defer C.free(unsafe.Pointer(cfile))
+
+	cmode := C.CString("wb")
+	defer C.free(unsafe.Pointer(cmode))
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	// Closes the file
+	return int(C.SDL_SaveBMP_RW(s.cSurface, C.SDL_RWFromFile(cfile, cmode), 1))
+}
+
+// Writes the surface as a BMP image.
+func (s *Surface) SaveBMP_RW(w io.Writer) error {
+	defer lockThread()()
+
+	s.check()
+
+	b := newRWBuffer()
+	defer b.free()
+
+	rw := C.callbacks_bufferRW(C.uintptr_t(b.handle))
+	if rw == nil {
+		return sdlError()
+	}
+
+	s.mutex.RLock()
+	status := C.SDL_SaveBMP_RW(s.cSurface, rw, 1) // Closes rw
+	s.mutex.RUnlock()
+
+	if status != 0 {
+		return sdlError()
+	}
+
+	_, err := w.Write(b.data)
+	return err
+}
+
+// A growable in-memory file which SDL writes to through an SDL_RWops
+// (see callbacks_bufferRW), for functions that need to seek back while
+// writing.
+type rwBuffer struct {
+	handle uintptr
+	data   []byte
+	pos    int64
+}
+
+var rwBuffersMutex sync.Mutex
+var rwBuffers = make(map[uintptr]*rwBuffer)
+var nextRWBuffer uintptr
+
+func newRWBuffer() *rwBuffer {
+	rwBuffersMutex.Lock()
+	defer rwBuffersMutex.Unlock()
+
+	nextRWBuffer++
+	b := &rwBuffer{handle: nextRWBuffer}
+	rwBuffers[b.handle] = b
+
+	return b
+}
+
+func (b *rwBuffer) free() {
+	rwBuffersMutex.Lock()
+	delete(rwBuffers, b.handle)
+	rwBuffersMutex.Unlock()
+}
+
+func lookupRWBuffer(handle C.uintptr_t) *rwBuffer {
+	rwBuffersMutex.Lock()
+	defer rwBuffersMutex.Unlock()
+
+	return rwBuffers[uintptr(handle)]
+}
+
+//export goBufferSize
+func goBufferSize(handle C.uintptr_t) (size C.Sint64) {
+	size = -1
+	defer recoverCallback("buffer size")
+
+	return C.Sint64(len(lookupRWBuffer(handle).data))
+}
+
+//export goBufferSeek
+func goBufferSeek(handle C.uintptr_t, offset C.Sint64, whence C.int) (result C.Sint64) {
+	result = -1
+	defer recoverCallback("buffer seek")
+
+	b := lookupRWBuffer(handle)
+
+	pos := int64(offset)
+	switch whence {
+	case C.RW_SEEK_CUR:
+		pos += b.pos
+	case C.RW_SEEK_END:
+		pos += int64(len(b.data))
+	}
+
+	if pos < 0 {
+		return -1
+	}
+
+	b.pos = pos
+	return C.Sint64(pos)
+}
+
+//export goBufferWrite
+func goBufferWrite(handle C.uintptr_t, p unsafe.Pointer, n C.size_t) (written C.size_t) {
+	written = 0
+	defer recoverCallback("buffer write")
+
+	b := lookupRWBuffer(handle)
+
+	end := b.pos + int64(n)
+	if end > int64(len(b.data)) {
+		b.data = append(b.data, make([]byte, end-int64(len(b.data)))...)
+	}
+	copy(b.data[b.pos:end], unsafe.Slice((*byte)(p), int(n)))
+	b.pos = end
+
+	return n
+}
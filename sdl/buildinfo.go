@@ -0,0 +1,136 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+// #include <SDL2/SDL_image.h>
+import "C"
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// A library version number.
+type Version struct {
+	Major uint8
+	Minor uint8
+	Patch uint8
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Describes one of the native libraries the bindings can use.
+type LibraryInfo struct {
+	Name     string
+	Linked   bool    // Whether the library is linked into this program
+	Compiled Version // Version of the headers the binding was compiled against
+	Runtime  Version // Version of the library loaded at run time
+}
+
+// Reports what a program built with these bindings can use.
+type Info struct {
+	// Native libraries, in a fixed order: SDL2, SDL2_image, SDL2_mixer,
+	// SDL2_ttf, SDL2_gfx, SDL2_net. Libraries of packages that are not
+	// imported by the program are reported as not linked.
+	Libraries []LibraryInfo
+
+	// Optional Go-side features selected with build tags.
+	Features []string
+}
+
+var buildInfoMutex sync.Mutex
+
+var libraries = []LibraryInfo{
+	{Name: "SDL2"},
+	{Name: "SDL2_image"},
+	{Name: "SDL2_mixer"},
+	{Name: "SDL2_ttf"},
+	{Name: "SDL2_gfx"},
+	{Name: "SDL2_net"},
+}
+
+var features []string
+
+// Why SDL2 or SDL2_image couldn't be loaded, in the sdl_dlopen build mode
+var loadErr, imageLoadErr error
+
+// Reports why SDL2 couldn't be loaded when the program was built with the
+// sdl_dlopen tag, in which case Init fails and no other function may be
+// called. Always nil otherwise: a program linked against SDL2 doesn't
+// start at all without it. A missing SDL2_image only shows in BuildInfo,
+// and the image loading functions must then not be used.
+func LoadError() error {
+	return loadErr
+}
+
+// Records a native library linked by one of the Go-SDL packages.
+// Packages such as "mixer" and "ttf" call this from their init function;
+// there is no need to use this in application code.
+func RegisterLibrary(info LibraryInfo) {
+	buildInfoMutex.Lock()
+	defer buildInfoMutex.Unlock()
+
+	for i := range libraries {
+		if libraries[i].Name == info.Name {
+			libraries[i] = info
+			return
+		}
+	}
+	libraries = append(libraries, info)
+}
+
+// Records an optional feature compiled in through a build tag.
+func registerFeature(name string) {
+	buildInfoMutex.Lock()
+	defer buildInfoMutex.Unlock()
+
+	features = append(features, name)
+	sort.Strings(features)
+}
+
+// Reports which native libraries are linked, their versions, and which
+// optional features were compiled in, so applications can adapt at startup.
+func BuildInfo() Info {
+	buildInfoMutex.Lock()
+	defer buildInfoMutex.Unlock()
+
+	info := Info{
+		Libraries: make([]LibraryInfo, len(libraries)),
+		Features:  make([]string, len(features)),
+	}
+	copy(info.Libraries, libraries)
+	copy(info.Features, features)
+
+	return info
+}
+
+func init() {
+	if loadErr != nil {
+		return
+	}
+
+	var v C.SDL_version
+	C.SDL_GetVersion(&v)
+
+	RegisterLibrary(LibraryInfo{
+		Name:     "SDL2",
+		Linked:   true,
+		Compiled: Version{C.SDL_MAJOR_VERSION, C.SDL_MINOR_VERSION, C.SDL_PATCHLEVEL},
+		Runtime:  Version{uint8(v.major), uint8(v.minor), uint8(v.patch)},
+	})
+
+	if imageLoadErr != nil {
+		return
+	}
+
+	img := C.IMG_Linked_Version()
+
+	RegisterLibrary(LibraryInfo{
+		Name:     "SDL2_image",
+		Linked:   true,
+		Compiled: Version{C.SDL_IMAGE_MAJOR_VERSION, C.SDL_IMAGE_MINOR_VERSION, C.SDL_IMAGE_PATCHLEVEL},
+		Runtime:  Version{uint8(img.major), uint8(img.minor), uint8(img.patch)},
+	})
+}
@@ -0,0 +1,92 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+//
+// static void setError(const char *message) {
+//	SDL_SetError("%s", message);
+// }
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"runtime/debug"
+	"sync"
+	"unsafe"
+)
+
+// Describes a panic in a Go callback invoked by SDL, such as a window hit
+// test.
+type CallbackPanic struct {
+	Callback string      // Name of the callback
+	Value    interface{} // Value passed to panic
+	Stack    []byte      // Stack trace of the panicking goroutine
+}
+
+func (p *CallbackPanic) Error() string {
+	return fmt.Sprintf("sdl: panic in %s callback: %v", p.Callback, p.Value)
+}
+
+var callbackPanicMutex sync.Mutex
+var callbackPanicHandler = logCallbackPanic
+var callbackPanicAbort = false
+
+func logCallbackPanic(p *CallbackPanic) {
+	log.Printf("%s\n%s", p.Error(), p.Stack)
+}
+
+// A panic that unwinds into C code cannot be recovered and takes the
+// whole process down with an unhelpful trace. Go callbacks invoked by SDL
+// therefore recover panics: the panic is turned into the current SDL error,
+// passed to the handler set here (by default it is logged with the
+// standard log package), and the callback returns a harmless default.
+// Passing nil restores the default handler.
+//
+// The handler runs on the thread SDL invoked the callback from, possibly
+// with GlobalMutex held, so it must not call functions of this package.
+func SetCallbackPanicHandler(handler func(p *CallbackPanic)) {
+	callbackPanicMutex.Lock()
+	defer callbackPanicMutex.Unlock()
+
+	if handler == nil {
+		handler = logCallbackPanic
+	}
+	callbackPanicHandler = handler
+}
+
+// When abort is true, the process exits with status 2 after the panic
+// handler has run, instead of continuing with the callback's default
+// result.
+func SetCallbackPanicAbort(abort bool) {
+	callbackPanicMutex.Lock()
+	defer callbackPanicMutex.Unlock()
+
+	callbackPanicAbort = abort
+}
+
+// Must be deferred directly by every function exported to C:
+//
+//	defer recoverCallback("hit test")
+func recoverCallback(name string) {
+	value := recover()
+	if value == nil {
+		return
+	}
+
+	p := &CallbackPanic{Callback: name, Value: value, Stack: debug.Stack()}
+
+	cmessage := C.CString(p.Error())
+	C.setError(cmessage)
+	C.free(unsafe.Pointer(cmessage))
+
+	callbackPanicMutex.Lock()
+	handler, abort := callbackPanicHandler, callbackPanicAbort
+	callbackPanicMutex.Unlock()
+
+	handler(p)
+
+	if abort {
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,51 @@
+#include "_cgo_export.h"
+
+static SDL_HitTestResult SDLCALL hitTest(SDL_Window *window, const SDL_Point *area, void *data) {
+	return (SDL_HitTestResult)goHitTest(window, area->x, area->y);
+}
+
+int callbacks_setWindowHitTest(SDL_Window *window, int enable) {
+	return SDL_SetWindowHitTest(window, enable ? hitTest : NULL, NULL);
+}
+
+static Sint64 SDLCALL bufferSize(SDL_RWops *rw) {
+	return goBufferSize((uintptr_t)rw->hidden.unknown.data1);
+}
+
+static Sint64 SDLCALL bufferSeek(SDL_RWops *rw, Sint64 offset, int whence) {
+	return goBufferSeek((uintptr_t)rw->hidden.unknown.data1, offset, whence);
+}
+
+static size_t SDLCALL bufferRead(SDL_RWops *rw, void *ptr, size_t size, size_t num) {
+	SDL_SetError("buffer is write-only");
+	return 0;
+}
+
+static size_t SDLCALL bufferWrite(SDL_RWops *rw, const void *ptr, size_t size, size_t num) {
+	if (size == 0) {
+		return 0;
+	}
+	return goBufferWrite((uintptr_t)rw->hidden.unknown.data1, (void *)ptr, size * num) / size;
+}
+
+static int SDLCALL bufferClose(SDL_RWops *rw) {
+	SDL_FreeRW(rw);
+	return 0;
+}
+
+SDL_RWops *callbacks_bufferRW(uintptr_t handle) {
+	SDL_RWops *rw = SDL_AllocRW();
+	if (rw == NULL) {
+		return NULL;
+	}
+
+	rw->size = bufferSize;
+	rw->seek = bufferSeek;
+	rw->read = bufferRead;
+	rw->write = bufferWrite;
+	rw->close = bufferClose;
+	rw->type = SDL_RWOPS_UNKNOWN;
+	rw->hidden.unknown.data1 = (void *)handle;
+
+	return rw;
+}
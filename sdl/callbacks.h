@@ -0,0 +1,5 @@
+#include <SDL2/SDL.h>
+#include <stdint.h>
+
+extern int callbacks_setWindowHitTest(SDL_Window *window, int enable);
+extern SDL_RWops *callbacks_bufferRW(uintptr_t handle);
@@ -0,0 +1,94 @@
+package sdl
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// An image.RGBA backed canvas for Go code that draws with image/draw (or
+// any library targeting draw.Image), presented through a streaming texture.
+//
+// Drawing marks the touched region dirty and Flush uploads only the dirty
+// region to the texture, so small updates to a large canvas stay cheap.
+// Code drawing into RGBA directly must report what it touched through
+// Invalidate.
+type Canvas struct {
+	RGBA *image.RGBA
+
+	renderer *Renderer
+	texture  *Texture
+	dirty    image.Rectangle
+}
+
+// Creates a w x h canvas and its streaming texture. Returns nil if the
+// texture cannot be created.
+func NewCanvas(r *Renderer, w, h int) *Canvas {
+	texture := CreateTexture(r, PIXELFORMAT_RGBA32, TEXTUREACCESS_STREAMING, w, h)
+	if texture == nil {
+		return nil
+	}
+
+	c := &Canvas{
+		RGBA:     image.NewRGBA(image.Rect(0, 0, w, h)),
+		renderer: r,
+		texture:  texture,
+	}
+	c.dirty = c.RGBA.Rect
+
+	return c
+}
+
+func (c *Canvas) ColorModel() color.Model { return color.RGBAModel }
+
+func (c *Canvas) Bounds() image.Rectangle { return c.RGBA.Rect }
+
+func (c *Canvas) At(x, y int) color.Color { return c.RGBA.At(x, y) }
+
+func (c *Canvas) Set(x, y int, col color.Color) {
+	c.RGBA.Set(x, y, col)
+	c.Invalidate(image.Rect(x, y, x+1, y+1))
+}
+
+// Draws src onto the canvas with image/draw, using its fast paths for
+// *image.RGBA destinations.
+func (c *Canvas) Draw(r image.Rectangle, src image.Image, sp image.Point, op draw.Op) {
+	draw.Draw(c.RGBA, r, src, sp, op)
+	c.Invalidate(r)
+}
+
+// Marks a region as modified, so the next Flush uploads it.
+func (c *Canvas) Invalidate(r image.Rectangle) {
+	c.dirty = c.dirty.Union(r.Intersect(c.RGBA.Rect))
+}
+
+// Uploads the dirty region to the texture.
+func (c *Canvas) Flush() {
+	if c.dirty.Empty() {
+		return
+	}
+
+	d := c.dirty
+	rect := Rect{int32(d.Min.X), int32(d.Min.Y), int32(d.Dx()), int32(d.Dy())}
+
+	c.texture.Update(&rect, c.RGBA.Pix[c.RGBA.PixOffset(d.Min.X, d.Min.Y):], c.RGBA.Stride)
+	c.dirty = image.Rectangle{}
+}
+
+// Returns the texture the canvas is uploaded to.
+func (c *Canvas) Texture() *Texture {
+	return c.texture
+}
+
+// Flushes the canvas, copies it to dst (the whole rendering target if dst
+// is nil) and presents the renderer.
+func (c *Canvas) Present(dst *Rect) {
+	c.Flush()
+	c.renderer.Copy(c.texture, nil, dst)
+	c.renderer.Present()
+}
+
+// Destroys the canvas' texture.
+func (c *Canvas) Destroy() {
+	c.texture.Destroy()
+}
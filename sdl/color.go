@@ -0,0 +1,22 @@
+package sdl
+
+import "image/color"
+
+// Converts colors of any type to Color, so Go's standard colors can be
+// used wherever the package expects a Color.
+var ColorModel = color.ModelFunc(colorModel)
+
+func colorModel(c color.Color) color.Color {
+	if c, ok := c.(Color); ok {
+		return c
+	}
+
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return Color{n.R, n.G, n.B, n.A}
+}
+
+// Implements color.Color. Color holds straight (non-premultiplied) alpha,
+// like color.NRGBA.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	return color.NRGBA{c.R, c.G, c.B, c.Alpha}.RGBA()
+}
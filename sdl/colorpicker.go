@@ -0,0 +1,323 @@
+package sdl
+
+import "math"
+
+// Space between the parts of a ColorPicker, in pixels.
+const pickerGap = 6
+
+// A color picker drawn with a renderer: a saturation/value square next to
+// a hue strip, with a row of palette swatches underneath. Dragging in the
+// square or the strip changes the color, clicking a swatch selects its
+// color.
+//
+// The picker is driven by the mouse events passed to HandleEvent and drawn
+// with Draw, typically once per frame.
+type ColorPicker struct {
+	X, Y int // Top left corner
+	Size int // Side of the saturation/value square
+
+	// Hue in degrees from 0 to 360, saturation and value from 0 to 1.
+	H, S, V float64
+
+	// Colors offered as swatches.
+	Swatches []Color
+
+	// Called whenever the user changes the color.
+	OnChange func(c Color)
+
+	renderer *Renderer
+	square   *Texture
+	strip    *Texture
+	squareH  float64 // Hue the square was last rendered for
+	dragging int
+}
+
+const (
+	pickerNone = iota
+	pickerSquare
+	pickerStrip
+)
+
+// Creates a picker at x, y with a size x size square, set to opaque white.
+// Returns nil if size is less than 2 or its textures cannot be created.
+func NewColorPicker(r *Renderer, x, y, size int) *ColorPicker {
+	if size < 2 {
+		return nil
+	}
+
+	p := &ColorPicker{X: x, Y: y, Size: size, V: 1, renderer: r, squareH: -1}
+
+	p.square = CreateTexture(r, PIXELFORMAT_RGBA32, TEXTUREACCESS_STREAMING, size, size)
+	p.strip = CreateTexture(r, PIXELFORMAT_RGBA32, TEXTUREACCESS_STATIC, 1, size)
+	if p.square == nil || p.strip == nil {
+		p.Destroy()
+		return nil
+	}
+
+	strip := make([]byte, 4*size)
+	for y := 0; y < size; y++ {
+		c := HSVToColor(360*float64(y)/float64(size), 1, 1)
+		strip[4*y], strip[4*y+1], strip[4*y+2], strip[4*y+3] = c.R, c.G, c.B, 255
+	}
+	p.strip.Update(nil, strip, 4)
+
+	return p
+}
+
+// Returns the selected color.
+func (p *ColorPicker) Color() Color {
+	return HSVToColor(p.H, p.S, p.V)
+}
+
+// Selects a color.
+func (p *ColorPicker) SetColor(c Color) {
+	h, s, v := ColorToHSV(c)
+
+	// Keep the hue for grays, where it can't be recovered from the color
+	if s > 0 {
+		p.H = h
+	}
+	p.S, p.V = s, v
+}
+
+// Updates the picker from mouse events and returns true if the color was
+// changed. Other events are ignored, so it is safe to pass every event
+// received from sdl.Events.
+func (p *ColorPicker) HandleEvent(event interface{}) bool {
+	switch e := event.(type) {
+	case MouseButtonEvent:
+		if e.Button != BUTTON_LEFT {
+			return false
+		}
+
+		if e.State == RELEASED {
+			p.dragging = pickerNone
+			return false
+		}
+
+		x, y := int(e.X), int(e.Y)
+		switch {
+		case p.inRect(x, y, p.squareRect()):
+			p.dragging = pickerSquare
+		case p.inRect(x, y, p.stripRect()):
+			p.dragging = pickerStrip
+		default:
+			if i := p.swatchAt(x, y); i >= 0 {
+				p.SetColor(p.Swatches[i])
+				p.changed()
+				return true
+			}
+			return false
+		}
+
+		return p.drag(x, y)
+
+	case MouseMotionEvent:
+		if p.dragging == pickerNone {
+			return false
+		}
+
+		return p.drag(int(e.X), int(e.Y))
+	}
+
+	return false
+}
+
+func (p *ColorPicker) drag(x, y int) bool {
+	fx := clampUnit(float64(x-p.X) / p.span())
+	fy := clampUnit(float64(y-p.Y) / p.span())
+
+	switch p.dragging {
+	case pickerSquare:
+		p.S, p.V = fx, 1-fy
+	case pickerStrip:
+		p.H = 360 * fy
+	default:
+		return false
+	}
+
+	p.changed()
+	return true
+}
+
+func (p *ColorPicker) changed() {
+	if p.OnChange != nil {
+		p.OnChange(p.Color())
+	}
+}
+
+// Draws the picker.
+func (p *ColorPicker) Draw() {
+	if p.H != p.squareH {
+		p.renderSquare()
+	}
+
+	square, strip := p.squareRect(), p.stripRect()
+	p.renderer.Copy(p.square, nil, &square)
+	p.renderer.Copy(p.strip, nil, &strip)
+
+	// Markers in a color that stands out from the selection
+	marker := Color{Alpha: 255}
+	if p.V < 0.5 {
+		marker = Color{R: 255, G: 255, B: 255, Alpha: 255}
+	}
+
+	p.renderer.SetDrawColor(marker)
+	mx := square.X + int32(p.S*p.span())
+	my := square.Y + int32((1-p.V)*p.span())
+	p.renderer.DrawRect(&Rect{mx - 3, my - 3, 7, 7})
+
+	p.renderer.SetDrawColor(Color{Alpha: 255})
+	hy := strip.Y + int32(p.H/360*p.span())
+	p.renderer.DrawRect(&Rect{strip.X - 2, hy - 1, strip.W + 4, 3})
+
+	for i, c := range p.Swatches {
+		rect := p.swatchRect(i)
+		c.Alpha = 255
+		p.renderer.SetDrawColor(c)
+		p.renderer.FillRect(&rect)
+		p.renderer.SetDrawColor(Color{R: 128, G: 128, B: 128, Alpha: 255})
+		p.renderer.DrawRect(&rect)
+	}
+}
+
+// Renders the saturation/value square for the current hue.
+func (p *ColorPicker) renderSquare() {
+	p.square.WithLock(nil, func(pixels *TexturePixels) {
+		for y := 0; y < pixels.H; y++ {
+			row := pixels.Row(y)
+			v := 1 - float64(y)/p.span()
+			for x := 0; x < pixels.W; x++ {
+				c := HSVToColor(p.H, float64(x)/p.span(), v)
+				row[4*x], row[4*x+1], row[4*x+2], row[4*x+3] = c.R, c.G, c.B, 255
+			}
+		}
+	})
+
+	p.squareH = p.H
+}
+
+// Returns the distance in pixels between the first and the last step of
+// the gradients, at least 1 so that it can be divided by.
+func (p *ColorPicker) span() float64 {
+	if p.Size < 2 {
+		return 1
+	}
+	return float64(p.Size - 1)
+}
+
+func (p *ColorPicker) squareRect() Rect {
+	return Rect{int32(p.X), int32(p.Y), int32(p.Size), int32(p.Size)}
+}
+
+func (p *ColorPicker) stripRect() Rect {
+	return Rect{int32(p.X + p.Size + pickerGap), int32(p.Y), int32(p.stripWidth()), int32(p.Size)}
+}
+
+func (p *ColorPicker) stripWidth() int {
+	return p.Size/8 + 1
+}
+
+// Swatches are laid out in rows as wide as the square and the strip.
+func (p *ColorPicker) swatchRect(i int) Rect {
+	side := p.stripWidth()
+	perRow := (p.Size + pickerGap + side + pickerGap) / (side + pickerGap)
+
+	x := p.X + (i%perRow)*(side+pickerGap)
+	y := p.Y + p.Size + pickerGap + (i/perRow)*(side+pickerGap)
+
+	return Rect{int32(x), int32(y), int32(side), int32(side)}
+}
+
+func (p *ColorPicker) swatchAt(x, y int) int {
+	for i := range p.Swatches {
+		if p.inRect(x, y, p.swatchRect(i)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *ColorPicker) inRect(x, y int, r Rect) bool {
+	return x >= int(r.X) && y >= int(r.Y) && x < int(r.X+r.W) && y < int(r.Y+r.H)
+}
+
+// Destroys the picker's textures.
+func (p *ColorPicker) Destroy() {
+	if p.square != nil {
+		p.square.Destroy()
+	}
+	if p.strip != nil {
+		p.strip.Destroy()
+	}
+}
+
+// Converts hue (in degrees), saturation and value (from 0 to 1) to an
+// opaque color.
+func HSVToColor(h, s, v float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	c := v * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := v - c
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return Color{
+		R:     uint8(math.Round((r + m) * 255)),
+		G:     uint8(math.Round((g + m) * 255)),
+		B:     uint8(math.Round((b + m) * 255)),
+		Alpha: 255,
+	}
+}
+
+// Converts a color to hue (in degrees), saturation and value (from 0 to 1).
+// The hue of grays is 0.
+func ColorToHSV(c Color) (h, s, v float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+	delta := hi - lo
+
+	switch {
+	case delta == 0:
+		h = 0
+	case hi == r:
+		h = 60 * math.Mod((g-b)/delta, 6)
+	case hi == g:
+		h = 60 * ((b-r)/delta + 2)
+	default:
+		h = 60 * ((r-g)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+
+	if hi > 0 {
+		s = delta / hi
+	}
+
+	return h, s, hi
+}
+
+func clampUnit(v float64) float64 {
+	return math.Max(0, math.Min(v, 1))
+}
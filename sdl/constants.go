@@ -1,6 +1,5 @@
 package sdl
 
-// #cgo pkg-config: sdl2
 // #include <SDL2/SDL.h>
 import "C"
 
@@ -35,9 +34,44 @@ const (
 	WINDOW_INPUT_FOCUS        = C.SDL_WINDOW_INPUT_FOCUS
 	WINDOW_MOUSE_FOCUS        = C.SDL_WINDOW_MOUSE_FOCUS
 	WINDOW_FOREIGN            = C.SDL_WINDOW_FOREIGN
+	WINDOW_MOUSE_GRABBED      = C.SDL_WINDOW_MOUSE_GRABBED
+	WINDOW_KEYBOARD_GRABBED   = C.SDL_WINDOW_KEYBOARD_GRABBED
+	WINDOW_ALWAYS_ON_TOP      = C.SDL_WINDOW_ALWAYS_ON_TOP
+	WINDOW_SKIP_TASKBAR       = C.SDL_WINDOW_SKIP_TASKBAR
+	WINDOW_UTILITY            = C.SDL_WINDOW_UTILITY
+	WINDOW_TOOLTIP            = C.SDL_WINDOW_TOOLTIP
+	WINDOW_POPUP_MENU         = C.SDL_WINDOW_POPUP_MENU
+	WINDOW_ALLOW_HIGHDPI      = C.SDL_WINDOW_ALLOW_HIGHDPI
+	WINDOW_METAL              = C.SDL_WINDOW_METAL
 
 	WINDOWPOS_UNDEFINED = C.SDL_WINDOWPOS_UNDEFINED
 
+	// window flash operations
+
+	FLASH_CANCEL        = C.SDL_FLASH_CANCEL
+	FLASH_BRIEFLY       = C.SDL_FLASH_BRIEFLY
+	FLASH_UNTIL_FOCUSED = C.SDL_FLASH_UNTIL_FOCUSED
+
+	// window shape modes
+
+	SHAPEMODE_DEFAULT                = C.ShapeModeDefault
+	SHAPEMODE_BINARIZE_ALPHA         = C.ShapeModeBinarizeAlpha
+	SHAPEMODE_REVERSE_BINARIZE_ALPHA = C.ShapeModeReverseBinarizeAlpha
+	SHAPEMODE_COLOR_KEY              = C.ShapeModeColorKey
+
+	// hit test results
+
+	HITTEST_NORMAL             = C.SDL_HITTEST_NORMAL
+	HITTEST_DRAGGABLE          = C.SDL_HITTEST_DRAGGABLE
+	HITTEST_RESIZE_TOPLEFT     = C.SDL_HITTEST_RESIZE_TOPLEFT
+	HITTEST_RESIZE_TOP         = C.SDL_HITTEST_RESIZE_TOP
+	HITTEST_RESIZE_TOPRIGHT    = C.SDL_HITTEST_RESIZE_TOPRIGHT
+	HITTEST_RESIZE_RIGHT       = C.SDL_HITTEST_RESIZE_RIGHT
+	HITTEST_RESIZE_BOTTOMRIGHT = C.SDL_HITTEST_RESIZE_BOTTOMRIGHT
+	HITTEST_RESIZE_BOTTOM      = C.SDL_HITTEST_RESIZE_BOTTOM
+	HITTEST_RESIZE_BOTTOMLEFT  = C.SDL_HITTEST_RESIZE_BOTTOMLEFT
+	HITTEST_RESIZE_LEFT        = C.SDL_HITTEST_RESIZE_LEFT
+
 	// Render flags
 
 	RENDERER_SOFTWARE      = C.SDL_RENDERER_SOFTWARE
@@ -65,22 +99,82 @@ const (
 	GL_ACCELERATED_VISUAL = C.SDL_GL_ACCELERATED_VISUAL
 	// GL_SWAP_CONTROL       = C.SDL_GL_SWAP_CONTROL
 
+	// GL context attributes
+
+	GL_RETAINED_BACKING           = C.SDL_GL_RETAINED_BACKING
+	GL_CONTEXT_MAJOR_VERSION      = C.SDL_GL_CONTEXT_MAJOR_VERSION
+	GL_CONTEXT_MINOR_VERSION      = C.SDL_GL_CONTEXT_MINOR_VERSION
+	GL_CONTEXT_EGL                = C.SDL_GL_CONTEXT_EGL
+	GL_CONTEXT_FLAGS              = C.SDL_GL_CONTEXT_FLAGS
+	GL_CONTEXT_PROFILE_MASK       = C.SDL_GL_CONTEXT_PROFILE_MASK
+	GL_SHARE_WITH_CURRENT_CONTEXT = C.SDL_GL_SHARE_WITH_CURRENT_CONTEXT
+	GL_FRAMEBUFFER_SRGB_CAPABLE   = C.SDL_GL_FRAMEBUFFER_SRGB_CAPABLE
+	GL_CONTEXT_RELEASE_BEHAVIOR   = C.SDL_GL_CONTEXT_RELEASE_BEHAVIOR
+	GL_CONTEXT_RESET_NOTIFICATION = C.SDL_GL_CONTEXT_RESET_NOTIFICATION
+	GL_CONTEXT_NO_ERROR           = C.SDL_GL_CONTEXT_NO_ERROR
+
+	// GL_CONTEXT_PROFILE_MASK values
+
+	GL_CONTEXT_PROFILE_CORE          = C.SDL_GL_CONTEXT_PROFILE_CORE
+	GL_CONTEXT_PROFILE_COMPATIBILITY = C.SDL_GL_CONTEXT_PROFILE_COMPATIBILITY
+	GL_CONTEXT_PROFILE_ES            = C.SDL_GL_CONTEXT_PROFILE_ES
+
+	// GL_CONTEXT_FLAGS bits
+
+	GL_CONTEXT_DEBUG_FLAG              = C.SDL_GL_CONTEXT_DEBUG_FLAG
+	GL_CONTEXT_FORWARD_COMPATIBLE_FLAG = C.SDL_GL_CONTEXT_FORWARD_COMPATIBLE_FLAG
+	GL_CONTEXT_ROBUST_ACCESS_FLAG      = C.SDL_GL_CONTEXT_ROBUST_ACCESS_FLAG
+	GL_CONTEXT_RESET_ISOLATION_FLAG    = C.SDL_GL_CONTEXT_RESET_ISOLATION_FLAG
+
+	// GL_CONTEXT_RELEASE_BEHAVIOR values
+
+	GL_CONTEXT_RELEASE_BEHAVIOR_NONE  = C.SDL_GL_CONTEXT_RELEASE_BEHAVIOR_NONE
+	GL_CONTEXT_RELEASE_BEHAVIOR_FLUSH = C.SDL_GL_CONTEXT_RELEASE_BEHAVIOR_FLUSH
+
+	// GL_CONTEXT_RESET_NOTIFICATION values
+
+	GL_CONTEXT_RESET_NO_NOTIFICATION = C.SDL_GL_CONTEXT_RESET_NO_NOTIFICATION
+	GL_CONTEXT_RESET_LOSE_CONTEXT    = C.SDL_GL_CONTEXT_RESET_LOSE_CONTEXT
+
 	// event types
 
 	KEYDOWN         = C.SDL_KEYDOWN
 	KEYUP           = C.SDL_KEYUP
+	TEXTEDITING     = C.SDL_TEXTEDITING
+	TEXTINPUT       = C.SDL_TEXTINPUT
 	MOUSEMOTION     = C.SDL_MOUSEMOTION
 	MOUSEBUTTONDOWN = C.SDL_MOUSEBUTTONDOWN
 	MOUSEBUTTONUP   = C.SDL_MOUSEBUTTONUP
+	MOUSEWHEEL      = C.SDL_MOUSEWHEEL
+	FINGERDOWN      = C.SDL_FINGERDOWN
+	FINGERUP        = C.SDL_FINGERUP
+	FINGERMOTION    = C.SDL_FINGERMOTION
 	JOYAXISMOTION   = C.SDL_JOYAXISMOTION
 	JOYBALLMOTION   = C.SDL_JOYBALLMOTION
 	JOYHATMOTION    = C.SDL_JOYHATMOTION
 	JOYBUTTONDOWN   = C.SDL_JOYBUTTONDOWN
 	JOYBUTTONUP     = C.SDL_JOYBUTTONUP
 	QUIT            = C.SDL_QUIT
+	WINDOWEVENT     = C.SDL_WINDOWEVENT
 	SYSWMEVENT      = C.SDL_SYSWMEVENT
 	USEREVENT       = C.SDL_USEREVENT
 
+	// render event types
+
+	RENDER_TARGETS_RESET = C.SDL_RENDER_TARGETS_RESET // The contents of target textures were lost
+	RENDER_DEVICE_RESET  = C.SDL_RENDER_DEVICE_RESET  // All textures were lost and must be recreated
+
+	// device and game controller event types
+
+	JOYDEVICEADDED           = C.SDL_JOYDEVICEADDED
+	JOYDEVICEREMOVED         = C.SDL_JOYDEVICEREMOVED
+	CONTROLLERAXISMOTION     = C.SDL_CONTROLLERAXISMOTION
+	CONTROLLERBUTTONDOWN     = C.SDL_CONTROLLERBUTTONDOWN
+	CONTROLLERBUTTONUP       = C.SDL_CONTROLLERBUTTONUP
+	CONTROLLERDEVICEADDED    = C.SDL_CONTROLLERDEVICEADDED
+	CONTROLLERDEVICEREMOVED  = C.SDL_CONTROLLERDEVICEREMOVED
+	CONTROLLERDEVICEREMAPPED = C.SDL_CONTROLLERDEVICEREMAPPED
+
 	// window events
 	WINDOWEVENT_SHOWN        = C.SDL_WINDOWEVENT_SHOWN
 	WINDOWEVENT_HIDDEN       = C.SDL_WINDOWEVENT_HIDDEN
@@ -97,6 +191,19 @@ const (
 	WINDOWEVENT_FOCUS_LOST   = C.SDL_WINDOWEVENT_FOCUS_LOST
 	WINDOWEVENT_CLOSE        = C.SDL_WINDOWEVENT_CLOSE
 
+	// mouse wheel directions
+
+	MOUSEWHEEL_NORMAL  = C.SDL_MOUSEWHEEL_NORMAL
+	MOUSEWHEEL_FLIPPED = C.SDL_MOUSEWHEEL_FLIPPED
+
+	// power states
+
+	POWERSTATE_UNKNOWN    = C.SDL_POWERSTATE_UNKNOWN
+	POWERSTATE_ON_BATTERY = C.SDL_POWERSTATE_ON_BATTERY
+	POWERSTATE_NO_BATTERY = C.SDL_POWERSTATE_NO_BATTERY
+	POWERSTATE_CHARGING   = C.SDL_POWERSTATE_CHARGING
+	POWERSTATE_CHARGED    = C.SDL_POWERSTATE_CHARGED
+
 	// event state
 
 	QUERY   = C.SDL_QUERY
@@ -221,6 +328,386 @@ const (
 	K_POWER        = C.SDLK_POWER
 	K_UNDO         = C.SDLK_UNDO
 
+	// more keys
+
+	K_PERCENT            = C.SDLK_PERCENT
+	K_PRINTSCREEN        = C.SDLK_PRINTSCREEN
+	K_SCROLLLOCK         = C.SDLK_SCROLLLOCK
+	K_NUMLOCKCLEAR       = C.SDLK_NUMLOCKCLEAR
+	K_APPLICATION        = C.SDLK_APPLICATION
+	K_LGUI               = C.SDLK_LGUI
+	K_RGUI               = C.SDLK_RGUI
+	K_F16                = C.SDLK_F16
+	K_F17                = C.SDLK_F17
+	K_F18                = C.SDLK_F18
+	K_F19                = C.SDLK_F19
+	K_F20                = C.SDLK_F20
+	K_F21                = C.SDLK_F21
+	K_F22                = C.SDLK_F22
+	K_F23                = C.SDLK_F23
+	K_F24                = C.SDLK_F24
+	K_EXECUTE            = C.SDLK_EXECUTE
+	K_SELECT             = C.SDLK_SELECT
+	K_STOP               = C.SDLK_STOP
+	K_AGAIN              = C.SDLK_AGAIN
+	K_CUT                = C.SDLK_CUT
+	K_COPY               = C.SDLK_COPY
+	K_PASTE              = C.SDLK_PASTE
+	K_FIND               = C.SDLK_FIND
+	K_MUTE               = C.SDLK_MUTE
+	K_VOLUMEUP           = C.SDLK_VOLUMEUP
+	K_VOLUMEDOWN         = C.SDLK_VOLUMEDOWN
+	K_ALTERASE           = C.SDLK_ALTERASE
+	K_CANCEL             = C.SDLK_CANCEL
+	K_PRIOR              = C.SDLK_PRIOR
+	K_RETURN2            = C.SDLK_RETURN2
+	K_SEPARATOR          = C.SDLK_SEPARATOR
+	K_OUT                = C.SDLK_OUT
+	K_OPER               = C.SDLK_OPER
+	K_CLEARAGAIN         = C.SDLK_CLEARAGAIN
+	K_CRSEL              = C.SDLK_CRSEL
+	K_EXSEL              = C.SDLK_EXSEL
+	K_THOUSANDSSEPARATOR = C.SDLK_THOUSANDSSEPARATOR
+	K_DECIMALSEPARATOR   = C.SDLK_DECIMALSEPARATOR
+	K_CURRENCYUNIT       = C.SDLK_CURRENCYUNIT
+	K_CURRENCYSUBUNIT    = C.SDLK_CURRENCYSUBUNIT
+
+	// keypad keys
+
+	K_KP_0              = C.SDLK_KP_0
+	K_KP_1              = C.SDLK_KP_1
+	K_KP_2              = C.SDLK_KP_2
+	K_KP_3              = C.SDLK_KP_3
+	K_KP_4              = C.SDLK_KP_4
+	K_KP_5              = C.SDLK_KP_5
+	K_KP_6              = C.SDLK_KP_6
+	K_KP_7              = C.SDLK_KP_7
+	K_KP_8              = C.SDLK_KP_8
+	K_KP_9              = C.SDLK_KP_9
+	K_KP_COMMA          = C.SDLK_KP_COMMA
+	K_KP_EQUALSAS400    = C.SDLK_KP_EQUALSAS400
+	K_KP_00             = C.SDLK_KP_00
+	K_KP_000            = C.SDLK_KP_000
+	K_KP_LEFTPAREN      = C.SDLK_KP_LEFTPAREN
+	K_KP_RIGHTPAREN     = C.SDLK_KP_RIGHTPAREN
+	K_KP_LEFTBRACE      = C.SDLK_KP_LEFTBRACE
+	K_KP_RIGHTBRACE     = C.SDLK_KP_RIGHTBRACE
+	K_KP_TAB            = C.SDLK_KP_TAB
+	K_KP_BACKSPACE      = C.SDLK_KP_BACKSPACE
+	K_KP_A              = C.SDLK_KP_A
+	K_KP_B              = C.SDLK_KP_B
+	K_KP_C              = C.SDLK_KP_C
+	K_KP_D              = C.SDLK_KP_D
+	K_KP_E              = C.SDLK_KP_E
+	K_KP_F              = C.SDLK_KP_F
+	K_KP_XOR            = C.SDLK_KP_XOR
+	K_KP_POWER          = C.SDLK_KP_POWER
+	K_KP_PERCENT        = C.SDLK_KP_PERCENT
+	K_KP_LESS           = C.SDLK_KP_LESS
+	K_KP_GREATER        = C.SDLK_KP_GREATER
+	K_KP_AMPERSAND      = C.SDLK_KP_AMPERSAND
+	K_KP_DBLAMPERSAND   = C.SDLK_KP_DBLAMPERSAND
+	K_KP_VERTICALBAR    = C.SDLK_KP_VERTICALBAR
+	K_KP_DBLVERTICALBAR = C.SDLK_KP_DBLVERTICALBAR
+	K_KP_COLON          = C.SDLK_KP_COLON
+	K_KP_HASH           = C.SDLK_KP_HASH
+	K_KP_SPACE          = C.SDLK_KP_SPACE
+	K_KP_AT             = C.SDLK_KP_AT
+	K_KP_EXCLAM         = C.SDLK_KP_EXCLAM
+	K_KP_MEMSTORE       = C.SDLK_KP_MEMSTORE
+	K_KP_MEMRECALL      = C.SDLK_KP_MEMRECALL
+	K_KP_MEMCLEAR       = C.SDLK_KP_MEMCLEAR
+	K_KP_MEMADD         = C.SDLK_KP_MEMADD
+	K_KP_MEMSUBTRACT    = C.SDLK_KP_MEMSUBTRACT
+	K_KP_MEMMULTIPLY    = C.SDLK_KP_MEMMULTIPLY
+	K_KP_MEMDIVIDE      = C.SDLK_KP_MEMDIVIDE
+	K_KP_PLUSMINUS      = C.SDLK_KP_PLUSMINUS
+	K_KP_CLEAR          = C.SDLK_KP_CLEAR
+	K_KP_CLEARENTRY     = C.SDLK_KP_CLEARENTRY
+	K_KP_BINARY         = C.SDLK_KP_BINARY
+	K_KP_OCTAL          = C.SDLK_KP_OCTAL
+	K_KP_DECIMAL        = C.SDLK_KP_DECIMAL
+	K_KP_HEXADECIMAL    = C.SDLK_KP_HEXADECIMAL
+
+	// media and application keys
+
+	K_AUDIONEXT        = C.SDLK_AUDIONEXT
+	K_AUDIOPREV        = C.SDLK_AUDIOPREV
+	K_AUDIOSTOP        = C.SDLK_AUDIOSTOP
+	K_AUDIOPLAY        = C.SDLK_AUDIOPLAY
+	K_AUDIOMUTE        = C.SDLK_AUDIOMUTE
+	K_MEDIASELECT      = C.SDLK_MEDIASELECT
+	K_WWW              = C.SDLK_WWW
+	K_MAIL             = C.SDLK_MAIL
+	K_CALCULATOR       = C.SDLK_CALCULATOR
+	K_COMPUTER         = C.SDLK_COMPUTER
+	K_AC_SEARCH        = C.SDLK_AC_SEARCH
+	K_AC_HOME          = C.SDLK_AC_HOME
+	K_AC_BACK          = C.SDLK_AC_BACK
+	K_AC_FORWARD       = C.SDLK_AC_FORWARD
+	K_AC_STOP          = C.SDLK_AC_STOP
+	K_AC_REFRESH       = C.SDLK_AC_REFRESH
+	K_AC_BOOKMARKS     = C.SDLK_AC_BOOKMARKS
+	K_BRIGHTNESSDOWN   = C.SDLK_BRIGHTNESSDOWN
+	K_BRIGHTNESSUP     = C.SDLK_BRIGHTNESSUP
+	K_DISPLAYSWITCH    = C.SDLK_DISPLAYSWITCH
+	K_KBDILLUMTOGGLE   = C.SDLK_KBDILLUMTOGGLE
+	K_KBDILLUMDOWN     = C.SDLK_KBDILLUMDOWN
+	K_KBDILLUMUP       = C.SDLK_KBDILLUMUP
+	K_EJECT            = C.SDLK_EJECT
+	K_SLEEP            = C.SDLK_SLEEP
+	K_APP1             = C.SDLK_APP1
+	K_APP2             = C.SDLK_APP2
+	K_AUDIOREWIND      = C.SDLK_AUDIOREWIND
+	K_AUDIOFASTFORWARD = C.SDLK_AUDIOFASTFORWARD
+
+	// scancodes
+
+	SCANCODE_UNKNOWN            = C.SDL_SCANCODE_UNKNOWN
+	SCANCODE_A                  = C.SDL_SCANCODE_A
+	SCANCODE_B                  = C.SDL_SCANCODE_B
+	SCANCODE_C                  = C.SDL_SCANCODE_C
+	SCANCODE_D                  = C.SDL_SCANCODE_D
+	SCANCODE_E                  = C.SDL_SCANCODE_E
+	SCANCODE_F                  = C.SDL_SCANCODE_F
+	SCANCODE_G                  = C.SDL_SCANCODE_G
+	SCANCODE_H                  = C.SDL_SCANCODE_H
+	SCANCODE_I                  = C.SDL_SCANCODE_I
+	SCANCODE_J                  = C.SDL_SCANCODE_J
+	SCANCODE_K                  = C.SDL_SCANCODE_K
+	SCANCODE_L                  = C.SDL_SCANCODE_L
+	SCANCODE_M                  = C.SDL_SCANCODE_M
+	SCANCODE_N                  = C.SDL_SCANCODE_N
+	SCANCODE_O                  = C.SDL_SCANCODE_O
+	SCANCODE_P                  = C.SDL_SCANCODE_P
+	SCANCODE_Q                  = C.SDL_SCANCODE_Q
+	SCANCODE_R                  = C.SDL_SCANCODE_R
+	SCANCODE_S                  = C.SDL_SCANCODE_S
+	SCANCODE_T                  = C.SDL_SCANCODE_T
+	SCANCODE_U                  = C.SDL_SCANCODE_U
+	SCANCODE_V                  = C.SDL_SCANCODE_V
+	SCANCODE_W                  = C.SDL_SCANCODE_W
+	SCANCODE_X                  = C.SDL_SCANCODE_X
+	SCANCODE_Y                  = C.SDL_SCANCODE_Y
+	SCANCODE_Z                  = C.SDL_SCANCODE_Z
+	SCANCODE_1                  = C.SDL_SCANCODE_1
+	SCANCODE_2                  = C.SDL_SCANCODE_2
+	SCANCODE_3                  = C.SDL_SCANCODE_3
+	SCANCODE_4                  = C.SDL_SCANCODE_4
+	SCANCODE_5                  = C.SDL_SCANCODE_5
+	SCANCODE_6                  = C.SDL_SCANCODE_6
+	SCANCODE_7                  = C.SDL_SCANCODE_7
+	SCANCODE_8                  = C.SDL_SCANCODE_8
+	SCANCODE_9                  = C.SDL_SCANCODE_9
+	SCANCODE_0                  = C.SDL_SCANCODE_0
+	SCANCODE_RETURN             = C.SDL_SCANCODE_RETURN
+	SCANCODE_ESCAPE             = C.SDL_SCANCODE_ESCAPE
+	SCANCODE_BACKSPACE          = C.SDL_SCANCODE_BACKSPACE
+	SCANCODE_TAB                = C.SDL_SCANCODE_TAB
+	SCANCODE_SPACE              = C.SDL_SCANCODE_SPACE
+	SCANCODE_MINUS              = C.SDL_SCANCODE_MINUS
+	SCANCODE_EQUALS             = C.SDL_SCANCODE_EQUALS
+	SCANCODE_LEFTBRACKET        = C.SDL_SCANCODE_LEFTBRACKET
+	SCANCODE_RIGHTBRACKET       = C.SDL_SCANCODE_RIGHTBRACKET
+	SCANCODE_BACKSLASH          = C.SDL_SCANCODE_BACKSLASH
+	SCANCODE_NONUSHASH          = C.SDL_SCANCODE_NONUSHASH
+	SCANCODE_SEMICOLON          = C.SDL_SCANCODE_SEMICOLON
+	SCANCODE_APOSTROPHE         = C.SDL_SCANCODE_APOSTROPHE
+	SCANCODE_GRAVE              = C.SDL_SCANCODE_GRAVE
+	SCANCODE_COMMA              = C.SDL_SCANCODE_COMMA
+	SCANCODE_PERIOD             = C.SDL_SCANCODE_PERIOD
+	SCANCODE_SLASH              = C.SDL_SCANCODE_SLASH
+	SCANCODE_CAPSLOCK           = C.SDL_SCANCODE_CAPSLOCK
+	SCANCODE_F1                 = C.SDL_SCANCODE_F1
+	SCANCODE_F2                 = C.SDL_SCANCODE_F2
+	SCANCODE_F3                 = C.SDL_SCANCODE_F3
+	SCANCODE_F4                 = C.SDL_SCANCODE_F4
+	SCANCODE_F5                 = C.SDL_SCANCODE_F5
+	SCANCODE_F6                 = C.SDL_SCANCODE_F6
+	SCANCODE_F7                 = C.SDL_SCANCODE_F7
+	SCANCODE_F8                 = C.SDL_SCANCODE_F8
+	SCANCODE_F9                 = C.SDL_SCANCODE_F9
+	SCANCODE_F10                = C.SDL_SCANCODE_F10
+	SCANCODE_F11                = C.SDL_SCANCODE_F11
+	SCANCODE_F12                = C.SDL_SCANCODE_F12
+	SCANCODE_PRINTSCREEN        = C.SDL_SCANCODE_PRINTSCREEN
+	SCANCODE_SCROLLLOCK         = C.SDL_SCANCODE_SCROLLLOCK
+	SCANCODE_PAUSE              = C.SDL_SCANCODE_PAUSE
+	SCANCODE_INSERT             = C.SDL_SCANCODE_INSERT
+	SCANCODE_HOME               = C.SDL_SCANCODE_HOME
+	SCANCODE_PAGEUP             = C.SDL_SCANCODE_PAGEUP
+	SCANCODE_DELETE             = C.SDL_SCANCODE_DELETE
+	SCANCODE_END                = C.SDL_SCANCODE_END
+	SCANCODE_PAGEDOWN           = C.SDL_SCANCODE_PAGEDOWN
+	SCANCODE_RIGHT              = C.SDL_SCANCODE_RIGHT
+	SCANCODE_LEFT               = C.SDL_SCANCODE_LEFT
+	SCANCODE_DOWN               = C.SDL_SCANCODE_DOWN
+	SCANCODE_UP                 = C.SDL_SCANCODE_UP
+	SCANCODE_NUMLOCKCLEAR       = C.SDL_SCANCODE_NUMLOCKCLEAR
+	SCANCODE_KP_DIVIDE          = C.SDL_SCANCODE_KP_DIVIDE
+	SCANCODE_KP_MULTIPLY        = C.SDL_SCANCODE_KP_MULTIPLY
+	SCANCODE_KP_MINUS           = C.SDL_SCANCODE_KP_MINUS
+	SCANCODE_KP_PLUS            = C.SDL_SCANCODE_KP_PLUS
+	SCANCODE_KP_ENTER           = C.SDL_SCANCODE_KP_ENTER
+	SCANCODE_KP_1               = C.SDL_SCANCODE_KP_1
+	SCANCODE_KP_2               = C.SDL_SCANCODE_KP_2
+	SCANCODE_KP_3               = C.SDL_SCANCODE_KP_3
+	SCANCODE_KP_4               = C.SDL_SCANCODE_KP_4
+	SCANCODE_KP_5               = C.SDL_SCANCODE_KP_5
+	SCANCODE_KP_6               = C.SDL_SCANCODE_KP_6
+	SCANCODE_KP_7               = C.SDL_SCANCODE_KP_7
+	SCANCODE_KP_8               = C.SDL_SCANCODE_KP_8
+	SCANCODE_KP_9               = C.SDL_SCANCODE_KP_9
+	SCANCODE_KP_0               = C.SDL_SCANCODE_KP_0
+	SCANCODE_KP_PERIOD          = C.SDL_SCANCODE_KP_PERIOD
+	SCANCODE_NONUSBACKSLASH     = C.SDL_SCANCODE_NONUSBACKSLASH
+	SCANCODE_APPLICATION        = C.SDL_SCANCODE_APPLICATION
+	SCANCODE_POWER              = C.SDL_SCANCODE_POWER
+	SCANCODE_KP_EQUALS          = C.SDL_SCANCODE_KP_EQUALS
+	SCANCODE_F13                = C.SDL_SCANCODE_F13
+	SCANCODE_F14                = C.SDL_SCANCODE_F14
+	SCANCODE_F15                = C.SDL_SCANCODE_F15
+	SCANCODE_F16                = C.SDL_SCANCODE_F16
+	SCANCODE_F17                = C.SDL_SCANCODE_F17
+	SCANCODE_F18                = C.SDL_SCANCODE_F18
+	SCANCODE_F19                = C.SDL_SCANCODE_F19
+	SCANCODE_F20                = C.SDL_SCANCODE_F20
+	SCANCODE_F21                = C.SDL_SCANCODE_F21
+	SCANCODE_F22                = C.SDL_SCANCODE_F22
+	SCANCODE_F23                = C.SDL_SCANCODE_F23
+	SCANCODE_F24                = C.SDL_SCANCODE_F24
+	SCANCODE_EXECUTE            = C.SDL_SCANCODE_EXECUTE
+	SCANCODE_HELP               = C.SDL_SCANCODE_HELP
+	SCANCODE_MENU               = C.SDL_SCANCODE_MENU
+	SCANCODE_SELECT             = C.SDL_SCANCODE_SELECT
+	SCANCODE_STOP               = C.SDL_SCANCODE_STOP
+	SCANCODE_AGAIN              = C.SDL_SCANCODE_AGAIN
+	SCANCODE_UNDO               = C.SDL_SCANCODE_UNDO
+	SCANCODE_CUT                = C.SDL_SCANCODE_CUT
+	SCANCODE_COPY               = C.SDL_SCANCODE_COPY
+	SCANCODE_PASTE              = C.SDL_SCANCODE_PASTE
+	SCANCODE_FIND               = C.SDL_SCANCODE_FIND
+	SCANCODE_MUTE               = C.SDL_SCANCODE_MUTE
+	SCANCODE_VOLUMEUP           = C.SDL_SCANCODE_VOLUMEUP
+	SCANCODE_VOLUMEDOWN         = C.SDL_SCANCODE_VOLUMEDOWN
+	SCANCODE_KP_COMMA           = C.SDL_SCANCODE_KP_COMMA
+	SCANCODE_KP_EQUALSAS400     = C.SDL_SCANCODE_KP_EQUALSAS400
+	SCANCODE_INTERNATIONAL1     = C.SDL_SCANCODE_INTERNATIONAL1
+	SCANCODE_INTERNATIONAL2     = C.SDL_SCANCODE_INTERNATIONAL2
+	SCANCODE_INTERNATIONAL3     = C.SDL_SCANCODE_INTERNATIONAL3
+	SCANCODE_INTERNATIONAL4     = C.SDL_SCANCODE_INTERNATIONAL4
+	SCANCODE_INTERNATIONAL5     = C.SDL_SCANCODE_INTERNATIONAL5
+	SCANCODE_INTERNATIONAL6     = C.SDL_SCANCODE_INTERNATIONAL6
+	SCANCODE_INTERNATIONAL7     = C.SDL_SCANCODE_INTERNATIONAL7
+	SCANCODE_INTERNATIONAL8     = C.SDL_SCANCODE_INTERNATIONAL8
+	SCANCODE_INTERNATIONAL9     = C.SDL_SCANCODE_INTERNATIONAL9
+	SCANCODE_LANG1              = C.SDL_SCANCODE_LANG1
+	SCANCODE_LANG2              = C.SDL_SCANCODE_LANG2
+	SCANCODE_LANG3              = C.SDL_SCANCODE_LANG3
+	SCANCODE_LANG4              = C.SDL_SCANCODE_LANG4
+	SCANCODE_LANG5              = C.SDL_SCANCODE_LANG5
+	SCANCODE_LANG6              = C.SDL_SCANCODE_LANG6
+	SCANCODE_LANG7              = C.SDL_SCANCODE_LANG7
+	SCANCODE_LANG8              = C.SDL_SCANCODE_LANG8
+	SCANCODE_LANG9              = C.SDL_SCANCODE_LANG9
+	SCANCODE_ALTERASE           = C.SDL_SCANCODE_ALTERASE
+	SCANCODE_SYSREQ             = C.SDL_SCANCODE_SYSREQ
+	SCANCODE_CANCEL             = C.SDL_SCANCODE_CANCEL
+	SCANCODE_CLEAR              = C.SDL_SCANCODE_CLEAR
+	SCANCODE_PRIOR              = C.SDL_SCANCODE_PRIOR
+	SCANCODE_RETURN2            = C.SDL_SCANCODE_RETURN2
+	SCANCODE_SEPARATOR          = C.SDL_SCANCODE_SEPARATOR
+	SCANCODE_OUT                = C.SDL_SCANCODE_OUT
+	SCANCODE_OPER               = C.SDL_SCANCODE_OPER
+	SCANCODE_CLEARAGAIN         = C.SDL_SCANCODE_CLEARAGAIN
+	SCANCODE_CRSEL              = C.SDL_SCANCODE_CRSEL
+	SCANCODE_EXSEL              = C.SDL_SCANCODE_EXSEL
+	SCANCODE_KP_00              = C.SDL_SCANCODE_KP_00
+	SCANCODE_KP_000             = C.SDL_SCANCODE_KP_000
+	SCANCODE_THOUSANDSSEPARATOR = C.SDL_SCANCODE_THOUSANDSSEPARATOR
+	SCANCODE_DECIMALSEPARATOR   = C.SDL_SCANCODE_DECIMALSEPARATOR
+	SCANCODE_CURRENCYUNIT       = C.SDL_SCANCODE_CURRENCYUNIT
+	SCANCODE_CURRENCYSUBUNIT    = C.SDL_SCANCODE_CURRENCYSUBUNIT
+	SCANCODE_KP_LEFTPAREN       = C.SDL_SCANCODE_KP_LEFTPAREN
+	SCANCODE_KP_RIGHTPAREN      = C.SDL_SCANCODE_KP_RIGHTPAREN
+	SCANCODE_KP_LEFTBRACE       = C.SDL_SCANCODE_KP_LEFTBRACE
+	SCANCODE_KP_RIGHTBRACE      = C.SDL_SCANCODE_KP_RIGHTBRACE
+	SCANCODE_KP_TAB             = C.SDL_SCANCODE_KP_TAB
+	SCANCODE_KP_BACKSPACE       = C.SDL_SCANCODE_KP_BACKSPACE
+	SCANCODE_KP_A               = C.SDL_SCANCODE_KP_A
+	SCANCODE_KP_B               = C.SDL_SCANCODE_KP_B
+	SCANCODE_KP_C               = C.SDL_SCANCODE_KP_C
+	SCANCODE_KP_D               = C.SDL_SCANCODE_KP_D
+	SCANCODE_KP_E               = C.SDL_SCANCODE_KP_E
+	SCANCODE_KP_F               = C.SDL_SCANCODE_KP_F
+	SCANCODE_KP_XOR             = C.SDL_SCANCODE_KP_XOR
+	SCANCODE_KP_POWER           = C.SDL_SCANCODE_KP_POWER
+	SCANCODE_KP_PERCENT         = C.SDL_SCANCODE_KP_PERCENT
+	SCANCODE_KP_LESS            = C.SDL_SCANCODE_KP_LESS
+	SCANCODE_KP_GREATER         = C.SDL_SCANCODE_KP_GREATER
+	SCANCODE_KP_AMPERSAND       = C.SDL_SCANCODE_KP_AMPERSAND
+	SCANCODE_KP_DBLAMPERSAND    = C.SDL_SCANCODE_KP_DBLAMPERSAND
+	SCANCODE_KP_VERTICALBAR     = C.SDL_SCANCODE_KP_VERTICALBAR
+	SCANCODE_KP_DBLVERTICALBAR  = C.SDL_SCANCODE_KP_DBLVERTICALBAR
+	SCANCODE_KP_COLON           = C.SDL_SCANCODE_KP_COLON
+	SCANCODE_KP_HASH            = C.SDL_SCANCODE_KP_HASH
+	SCANCODE_KP_SPACE           = C.SDL_SCANCODE_KP_SPACE
+	SCANCODE_KP_AT              = C.SDL_SCANCODE_KP_AT
+	SCANCODE_KP_EXCLAM          = C.SDL_SCANCODE_KP_EXCLAM
+	SCANCODE_KP_MEMSTORE        = C.SDL_SCANCODE_KP_MEMSTORE
+	SCANCODE_KP_MEMRECALL       = C.SDL_SCANCODE_KP_MEMRECALL
+	SCANCODE_KP_MEMCLEAR        = C.SDL_SCANCODE_KP_MEMCLEAR
+	SCANCODE_KP_MEMADD          = C.SDL_SCANCODE_KP_MEMADD
+	SCANCODE_KP_MEMSUBTRACT     = C.SDL_SCANCODE_KP_MEMSUBTRACT
+	SCANCODE_KP_MEMMULTIPLY     = C.SDL_SCANCODE_KP_MEMMULTIPLY
+	SCANCODE_KP_MEMDIVIDE       = C.SDL_SCANCODE_KP_MEMDIVIDE
+	SCANCODE_KP_PLUSMINUS       = C.SDL_SCANCODE_KP_PLUSMINUS
+	SCANCODE_KP_CLEAR           = C.SDL_SCANCODE_KP_CLEAR
+	SCANCODE_KP_CLEARENTRY      = C.SDL_SCANCODE_KP_CLEARENTRY
+	SCANCODE_KP_BINARY          = C.SDL_SCANCODE_KP_BINARY
+	SCANCODE_KP_OCTAL           = C.SDL_SCANCODE_KP_OCTAL
+	SCANCODE_KP_DECIMAL         = C.SDL_SCANCODE_KP_DECIMAL
+	SCANCODE_KP_HEXADECIMAL     = C.SDL_SCANCODE_KP_HEXADECIMAL
+	SCANCODE_LCTRL              = C.SDL_SCANCODE_LCTRL
+	SCANCODE_LSHIFT             = C.SDL_SCANCODE_LSHIFT
+	SCANCODE_LALT               = C.SDL_SCANCODE_LALT
+	SCANCODE_LGUI               = C.SDL_SCANCODE_LGUI
+	SCANCODE_RCTRL              = C.SDL_SCANCODE_RCTRL
+	SCANCODE_RSHIFT             = C.SDL_SCANCODE_RSHIFT
+	SCANCODE_RALT               = C.SDL_SCANCODE_RALT
+	SCANCODE_RGUI               = C.SDL_SCANCODE_RGUI
+	SCANCODE_MODE               = C.SDL_SCANCODE_MODE
+	SCANCODE_AUDIONEXT          = C.SDL_SCANCODE_AUDIONEXT
+	SCANCODE_AUDIOPREV          = C.SDL_SCANCODE_AUDIOPREV
+	SCANCODE_AUDIOSTOP          = C.SDL_SCANCODE_AUDIOSTOP
+	SCANCODE_AUDIOPLAY          = C.SDL_SCANCODE_AUDIOPLAY
+	SCANCODE_AUDIOMUTE          = C.SDL_SCANCODE_AUDIOMUTE
+	SCANCODE_MEDIASELECT        = C.SDL_SCANCODE_MEDIASELECT
+	SCANCODE_WWW                = C.SDL_SCANCODE_WWW
+	SCANCODE_MAIL               = C.SDL_SCANCODE_MAIL
+	SCANCODE_CALCULATOR         = C.SDL_SCANCODE_CALCULATOR
+	SCANCODE_COMPUTER           = C.SDL_SCANCODE_COMPUTER
+	SCANCODE_AC_SEARCH          = C.SDL_SCANCODE_AC_SEARCH
+	SCANCODE_AC_HOME            = C.SDL_SCANCODE_AC_HOME
+	SCANCODE_AC_BACK            = C.SDL_SCANCODE_AC_BACK
+	SCANCODE_AC_FORWARD         = C.SDL_SCANCODE_AC_FORWARD
+	SCANCODE_AC_STOP            = C.SDL_SCANCODE_AC_STOP
+	SCANCODE_AC_REFRESH         = C.SDL_SCANCODE_AC_REFRESH
+	SCANCODE_AC_BOOKMARKS       = C.SDL_SCANCODE_AC_BOOKMARKS
+	SCANCODE_BRIGHTNESSDOWN     = C.SDL_SCANCODE_BRIGHTNESSDOWN
+	SCANCODE_BRIGHTNESSUP       = C.SDL_SCANCODE_BRIGHTNESSUP
+	SCANCODE_DISPLAYSWITCH      = C.SDL_SCANCODE_DISPLAYSWITCH
+	SCANCODE_KBDILLUMTOGGLE     = C.SDL_SCANCODE_KBDILLUMTOGGLE
+	SCANCODE_KBDILLUMDOWN       = C.SDL_SCANCODE_KBDILLUMDOWN
+	SCANCODE_KBDILLUMUP         = C.SDL_SCANCODE_KBDILLUMUP
+	SCANCODE_EJECT              = C.SDL_SCANCODE_EJECT
+	SCANCODE_SLEEP              = C.SDL_SCANCODE_SLEEP
+	SCANCODE_APP1               = C.SDL_SCANCODE_APP1
+	SCANCODE_APP2               = C.SDL_SCANCODE_APP2
+	SCANCODE_AUDIOREWIND        = C.SDL_SCANCODE_AUDIOREWIND
+	SCANCODE_AUDIOFASTFORWARD   = C.SDL_SCANCODE_AUDIOFASTFORWARD
+	SCANCODE_NUM_SCANCODES      = C.SDL_SCANCODE_NUM_SCANCODES
+
 	// key mods
 
 	KMOD_NONE     = C.KMOD_NONE
@@ -233,6 +720,7 @@ const (
 	KMOD_NUM      = C.KMOD_NUM
 	KMOD_CAPS     = C.KMOD_CAPS
 	KMOD_MODE     = C.KMOD_MODE
+	KMOD_SCROLL   = C.KMOD_SCROLL
 	KMOD_RESERVED = C.KMOD_RESERVED
 	KMOD_LGUI     = C.KMOD_LGUI
 	KMOD_RGUI     = C.KMOD_RGUI
@@ -280,6 +768,56 @@ const (
 	MESSAGEBOX_WARNING     = C.SDL_MESSAGEBOX_WARNING
 	MESSAGEBOX_INFORMATION = C.SDL_MESSAGEBOX_INFORMATION
 
+	MESSAGEBOX_BUTTON_RETURNKEY_DEFAULT = C.SDL_MESSAGEBOX_BUTTON_RETURNKEY_DEFAULT
+	MESSAGEBOX_BUTTON_ESCAPEKEY_DEFAULT = C.SDL_MESSAGEBOX_BUTTON_ESCAPEKEY_DEFAULT
+
+	MESSAGEBOX_COLOR_BACKGROUND        = C.SDL_MESSAGEBOX_COLOR_BACKGROUND
+	MESSAGEBOX_COLOR_TEXT              = C.SDL_MESSAGEBOX_COLOR_TEXT
+	MESSAGEBOX_COLOR_BUTTON_BORDER     = C.SDL_MESSAGEBOX_COLOR_BUTTON_BORDER
+	MESSAGEBOX_COLOR_BUTTON_BACKGROUND = C.SDL_MESSAGEBOX_COLOR_BUTTON_BACKGROUND
+	MESSAGEBOX_COLOR_BUTTON_SELECTED   = C.SDL_MESSAGEBOX_COLOR_BUTTON_SELECTED
+	MESSAGEBOX_COLOR_MAX               = C.SDL_MESSAGEBOX_COLOR_MAX
+
+	// renderer flip
+
+	FLIP_NONE       = C.SDL_FLIP_NONE
+	FLIP_HORIZONTAL = C.SDL_FLIP_HORIZONTAL
+	FLIP_VERTICAL   = C.SDL_FLIP_VERTICAL
+
+	// blend modes
+
+	BLENDMODE_NONE    = C.SDL_BLENDMODE_NONE
+	BLENDMODE_BLEND   = C.SDL_BLENDMODE_BLEND
+	BLENDMODE_ADD     = C.SDL_BLENDMODE_ADD
+	BLENDMODE_MOD     = C.SDL_BLENDMODE_MOD
+	BLENDMODE_MUL     = C.SDL_BLENDMODE_MUL
+	BLENDMODE_INVALID = C.SDL_BLENDMODE_INVALID
+
+	// custom blend mode factors and operations
+
+	BLENDFACTOR_ZERO                = C.SDL_BLENDFACTOR_ZERO
+	BLENDFACTOR_ONE                 = C.SDL_BLENDFACTOR_ONE
+	BLENDFACTOR_SRC_COLOR           = C.SDL_BLENDFACTOR_SRC_COLOR
+	BLENDFACTOR_ONE_MINUS_SRC_COLOR = C.SDL_BLENDFACTOR_ONE_MINUS_SRC_COLOR
+	BLENDFACTOR_SRC_ALPHA           = C.SDL_BLENDFACTOR_SRC_ALPHA
+	BLENDFACTOR_ONE_MINUS_SRC_ALPHA = C.SDL_BLENDFACTOR_ONE_MINUS_SRC_ALPHA
+	BLENDFACTOR_DST_COLOR           = C.SDL_BLENDFACTOR_DST_COLOR
+	BLENDFACTOR_ONE_MINUS_DST_COLOR = C.SDL_BLENDFACTOR_ONE_MINUS_DST_COLOR
+	BLENDFACTOR_DST_ALPHA           = C.SDL_BLENDFACTOR_DST_ALPHA
+	BLENDFACTOR_ONE_MINUS_DST_ALPHA = C.SDL_BLENDFACTOR_ONE_MINUS_DST_ALPHA
+
+	BLENDOPERATION_ADD          = C.SDL_BLENDOPERATION_ADD
+	BLENDOPERATION_SUBTRACT     = C.SDL_BLENDOPERATION_SUBTRACT
+	BLENDOPERATION_REV_SUBTRACT = C.SDL_BLENDOPERATION_REV_SUBTRACT
+	BLENDOPERATION_MINIMUM      = C.SDL_BLENDOPERATION_MINIMUM
+	BLENDOPERATION_MAXIMUM      = C.SDL_BLENDOPERATION_MAXIMUM
+
+	// texture scale modes
+
+	SCALEMODE_NEAREST = C.SDL_ScaleModeNearest
+	SCALEMODE_LINEAR  = C.SDL_ScaleModeLinear
+	SCALEMODE_BEST    = C.SDL_ScaleModeBest
+
 	// pixel format
 
 	PIXELFORMAT_UNKNOWN     = C.SDL_PIXELFORMAT_UNKNOWN
@@ -290,8 +828,13 @@ const (
 	PIXELFORMAT_INDEX8      = C.SDL_PIXELFORMAT_INDEX8
 	PIXELFORMAT_RGB332      = C.SDL_PIXELFORMAT_RGB332
 	PIXELFORMAT_RGB444      = C.SDL_PIXELFORMAT_RGB444
+	PIXELFORMAT_BGR444      = C.SDL_PIXELFORMAT_BGR444
+	PIXELFORMAT_XRGB4444    = C.SDL_PIXELFORMAT_XRGB4444
+	PIXELFORMAT_XBGR4444    = C.SDL_PIXELFORMAT_XBGR4444
 	PIXELFORMAT_RGB555      = C.SDL_PIXELFORMAT_RGB555
 	PIXELFORMAT_BGR555      = C.SDL_PIXELFORMAT_BGR555
+	PIXELFORMAT_XRGB1555    = C.SDL_PIXELFORMAT_XRGB1555
+	PIXELFORMAT_XBGR1555    = C.SDL_PIXELFORMAT_XBGR1555
 	PIXELFORMAT_ARGB4444    = C.SDL_PIXELFORMAT_ARGB4444
 	PIXELFORMAT_RGBA4444    = C.SDL_PIXELFORMAT_RGBA4444
 	PIXELFORMAT_ABGR4444    = C.SDL_PIXELFORMAT_ABGR4444
@@ -308,19 +851,32 @@ const (
 	PIXELFORMAT_RGBX8888    = C.SDL_PIXELFORMAT_RGBX8888
 	PIXELFORMAT_BGR888      = C.SDL_PIXELFORMAT_BGR888
 	PIXELFORMAT_BGRX8888    = C.SDL_PIXELFORMAT_BGRX8888
+	PIXELFORMAT_XRGB8888    = C.SDL_PIXELFORMAT_XRGB8888
+	PIXELFORMAT_XBGR8888    = C.SDL_PIXELFORMAT_XBGR8888
 	PIXELFORMAT_ARGB8888    = C.SDL_PIXELFORMAT_ARGB8888
 	PIXELFORMAT_RGBA8888    = C.SDL_PIXELFORMAT_RGBA8888
 	PIXELFORMAT_ABGR8888    = C.SDL_PIXELFORMAT_ABGR8888
 	PIXELFORMAT_BGRA8888    = C.SDL_PIXELFORMAT_BGRA8888
 	PIXELFORMAT_ARGB2101010 = C.SDL_PIXELFORMAT_ARGB2101010
+	PIXELFORMAT_RGBA32      = C.SDL_PIXELFORMAT_RGBA32
+	PIXELFORMAT_ARGB32      = C.SDL_PIXELFORMAT_ARGB32
+	PIXELFORMAT_BGRA32      = C.SDL_PIXELFORMAT_BGRA32
+	PIXELFORMAT_ABGR32      = C.SDL_PIXELFORMAT_ABGR32
+	PIXELFORMAT_RGBX32      = C.SDL_PIXELFORMAT_RGBX32
+	PIXELFORMAT_XRGB32      = C.SDL_PIXELFORMAT_XRGB32
+	PIXELFORMAT_BGRX32      = C.SDL_PIXELFORMAT_BGRX32
+	PIXELFORMAT_XBGR32      = C.SDL_PIXELFORMAT_XBGR32
 	PIXELFORMAT_YV12        = C.SDL_PIXELFORMAT_YV12
 	PIXELFORMAT_IYUV        = C.SDL_PIXELFORMAT_IYUV
 	PIXELFORMAT_YUY2        = C.SDL_PIXELFORMAT_YUY2
 	PIXELFORMAT_UYVY        = C.SDL_PIXELFORMAT_UYVY
 	PIXELFORMAT_YVYU        = C.SDL_PIXELFORMAT_YVYU
+	PIXELFORMAT_NV12        = C.SDL_PIXELFORMAT_NV12
+	PIXELFORMAT_NV21        = C.SDL_PIXELFORMAT_NV21
 
 	// texture access
 
 	TEXTUREACCESS_STATIC    = C.SDL_TEXTUREACCESS_STATIC
 	TEXTUREACCESS_STREAMING = C.SDL_TEXTUREACCESS_STREAMING
+	TEXTUREACCESS_TARGET    = C.SDL_TEXTUREACCESS_TARGET
 )
@@ -0,0 +1,81 @@
+//go:build sdl_dlopen && !sdl_static
+
+/*
+ * Loads SDL2 and SDL2_image at run time for the sdl_dlopen build mode.
+ * Each function listed in dlopen_symbols.h gets a pointer here, which the
+ * trampolines in dlopen_linux_*.S jump through.
+ */
+
+#include <dlfcn.h>
+#include <stdio.h>
+#include <stdlib.h>
+
+static void unavailable(void) {
+	fputs("sdl: called an SDL function that could not be loaded\n", stderr);
+	abort();
+}
+
+#define SDL_DL_FUNC(name) __attribute__((visibility("hidden"))) void *dlopen_##name = (void *)unavailable;
+#define IMG_DL_FUNC(name) SDL_DL_FUNC(name)
+#include "dlopen_symbols.h"
+#undef SDL_DL_FUNC
+#undef IMG_DL_FUNC
+
+static void *openLibrary(const char *const names[], char *err, int size) {
+	for (int i = 0; names[i] != NULL; i++) {
+		void *lib = dlopen(names[i], RTLD_NOW | RTLD_GLOBAL);
+		if (lib != NULL) {
+			return lib;
+		}
+
+		// Report why the usual name failed, not the fallbacks
+		if (i == 0) {
+			snprintf(err, size, "%s", dlerror());
+		}
+	}
+
+	return NULL;
+}
+
+// Functions missing from older versions of the library keep pointing to
+// unavailable, so that the program only fails if it calls them
+static void resolve(void *lib, void **fn, const char *name) {
+	void *p = dlsym(lib, name);
+	if (p != NULL) {
+		*fn = p;
+	}
+}
+
+int dlopen_loadSDL(char *err, int size) {
+	static const char *const names[] = {"libSDL2-2.0.so.0", "libSDL2.so", NULL};
+
+	void *lib = openLibrary(names, err, size);
+	if (lib == NULL) {
+		return -1;
+	}
+
+#define SDL_DL_FUNC(name) resolve(lib, &dlopen_##name, #name);
+#define IMG_DL_FUNC(name)
+#include "dlopen_symbols.h"
+#undef SDL_DL_FUNC
+#undef IMG_DL_FUNC
+
+	return 0;
+}
+
+int dlopen_loadImage(char *err, int size) {
+	static const char *const names[] = {"libSDL2_image-2.0.so.0", "libSDL2_image.so", NULL};
+
+	void *lib = openLibrary(names, err, size);
+	if (lib == NULL) {
+		return -1;
+	}
+
+#define SDL_DL_FUNC(name)
+#define IMG_DL_FUNC(name) resolve(lib, &dlopen_##name, #name);
+#include "dlopen_symbols.h"
+#undef SDL_DL_FUNC
+#undef IMG_DL_FUNC
+
+	return 0;
+}
@@ -0,0 +1,287 @@
+/*
+ * The SDL2 and SDL2_image functions called by the Go-SDL2 packages, for
+ * the sdl_dlopen build mode. Every function called from Go or C code
+ * must be listed here, or the program will fail to link in that mode.
+ */
+
+SDL_DL_FUNC(SDL_AllocFormat)
+SDL_DL_FUNC(SDL_AllocPalette)
+SDL_DL_FUNC(SDL_AllocRW)
+SDL_DL_FUNC(SDL_CalculateGammaRamp)
+SDL_DL_FUNC(SDL_ClearError)
+SDL_DL_FUNC(SDL_CloseAudio)
+SDL_DL_FUNC(SDL_ComposeCustomBlendMode)
+SDL_DL_FUNC(SDL_ConvertPixels)
+SDL_DL_FUNC(SDL_ConvertSurface)
+SDL_DL_FUNC(SDL_ConvertSurfaceFormat)
+SDL_DL_FUNC(SDL_CreateRGBSurface)
+SDL_DL_FUNC(SDL_CreateRGBSurfaceFrom)
+SDL_DL_FUNC(SDL_CreateRGBSurfaceWithFormat)
+SDL_DL_FUNC(SDL_CreateRGBSurfaceWithFormatFrom)
+SDL_DL_FUNC(SDL_CreateRenderer)
+SDL_DL_FUNC(SDL_CreateShapedWindow)
+SDL_DL_FUNC(SDL_CreateSoftwareRenderer)
+SDL_DL_FUNC(SDL_CreateTexture)
+SDL_DL_FUNC(SDL_CreateTextureFromSurface)
+SDL_DL_FUNC(SDL_CreateWindow)
+SDL_DL_FUNC(SDL_CreateWindowAndRenderer)
+SDL_DL_FUNC(SDL_DestroyRenderer)
+SDL_DL_FUNC(SDL_DestroyTexture)
+SDL_DL_FUNC(SDL_DestroyWindow)
+SDL_DL_FUNC(SDL_DisableScreenSaver)
+SDL_DL_FUNC(SDL_DuplicateSurface)
+SDL_DL_FUNC(SDL_EnableScreenSaver)
+SDL_DL_FUNC(SDL_EncloseFPoints)
+SDL_DL_FUNC(SDL_EnclosePoints)
+SDL_DL_FUNC(SDL_FillRect)
+SDL_DL_FUNC(SDL_FillRects)
+SDL_DL_FUNC(SDL_FlashWindow)
+SDL_DL_FUNC(SDL_FreeFormat)
+SDL_DL_FUNC(SDL_FreePalette)
+SDL_DL_FUNC(SDL_FreeRW)
+SDL_DL_FUNC(SDL_FreeSurface)
+SDL_DL_FUNC(SDL_GL_BindTexture)
+SDL_DL_FUNC(SDL_GL_CreateContext)
+SDL_DL_FUNC(SDL_GL_DeleteContext)
+SDL_DL_FUNC(SDL_GL_GetAttribute)
+SDL_DL_FUNC(SDL_GL_GetCurrentContext)
+SDL_DL_FUNC(SDL_GL_GetCurrentWindow)
+SDL_DL_FUNC(SDL_GL_GetDrawableSize)
+SDL_DL_FUNC(SDL_GL_GetProcAddress)
+SDL_DL_FUNC(SDL_GL_GetSwapInterval)
+SDL_DL_FUNC(SDL_GL_LoadLibrary)
+SDL_DL_FUNC(SDL_GL_MakeCurrent)
+SDL_DL_FUNC(SDL_GL_SetAttribute)
+SDL_DL_FUNC(SDL_GL_SetSwapInterval)
+SDL_DL_FUNC(SDL_GL_SwapWindow)
+SDL_DL_FUNC(SDL_GL_UnbindTexture)
+SDL_DL_FUNC(SDL_GL_UnloadLibrary)
+SDL_DL_FUNC(SDL_GameControllerAddMapping)
+SDL_DL_FUNC(SDL_GetAudioStatus)
+SDL_DL_FUNC(SDL_GetBasePath)
+SDL_DL_FUNC(SDL_GetClipRect)
+SDL_DL_FUNC(SDL_GetColorKey)
+SDL_DL_FUNC(SDL_GetCurrentDisplayMode)
+SDL_DL_FUNC(SDL_GetDesktopDisplayMode)
+SDL_DL_FUNC(SDL_GetDisplayBounds)
+SDL_DL_FUNC(SDL_GetDisplayDPI)
+SDL_DL_FUNC(SDL_GetDisplayMode)
+SDL_DL_FUNC(SDL_GetDisplayName)
+SDL_DL_FUNC(SDL_GetDisplayUsableBounds)
+SDL_DL_FUNC(SDL_GetError)
+SDL_DL_FUNC(SDL_GetErrorMsg)
+SDL_DL_FUNC(SDL_GetGrabbedWindow)
+SDL_DL_FUNC(SDL_GetKeyFromName)
+SDL_DL_FUNC(SDL_GetKeyFromScancode)
+SDL_DL_FUNC(SDL_GetKeyName)
+SDL_DL_FUNC(SDL_GetKeyboardState)
+SDL_DL_FUNC(SDL_GetModState)
+SDL_DL_FUNC(SDL_GetMouseState)
+SDL_DL_FUNC(SDL_GetNumDisplayModes)
+SDL_DL_FUNC(SDL_GetNumRenderDrivers)
+SDL_DL_FUNC(SDL_GetNumVideoDisplays)
+SDL_DL_FUNC(SDL_GetPixelFormatName)
+SDL_DL_FUNC(SDL_GetPowerInfo)
+SDL_DL_FUNC(SDL_GetPrefPath)
+SDL_DL_FUNC(SDL_GetRGB)
+SDL_DL_FUNC(SDL_GetRGBA)
+SDL_DL_FUNC(SDL_GetRelativeMouseState)
+SDL_DL_FUNC(SDL_GetRenderDrawBlendMode)
+SDL_DL_FUNC(SDL_GetRenderDrawColor)
+SDL_DL_FUNC(SDL_GetRenderDriverInfo)
+SDL_DL_FUNC(SDL_GetRenderTarget)
+SDL_DL_FUNC(SDL_GetRenderer)
+SDL_DL_FUNC(SDL_GetRendererInfo)
+SDL_DL_FUNC(SDL_GetRendererOutputSize)
+SDL_DL_FUNC(SDL_GetScancodeFromKey)
+SDL_DL_FUNC(SDL_GetScancodeFromName)
+SDL_DL_FUNC(SDL_GetScancodeName)
+SDL_DL_FUNC(SDL_GetSurfaceAlphaMod)
+SDL_DL_FUNC(SDL_GetSurfaceBlendMode)
+SDL_DL_FUNC(SDL_GetSurfaceColorMod)
+SDL_DL_FUNC(SDL_GetTextureAlphaMod)
+SDL_DL_FUNC(SDL_GetTextureBlendMode)
+SDL_DL_FUNC(SDL_GetTextureColorMod)
+SDL_DL_FUNC(SDL_GetTextureScaleMode)
+SDL_DL_FUNC(SDL_GetTicks)
+SDL_DL_FUNC(SDL_GetVersion)
+SDL_DL_FUNC(SDL_GetWindowBrightness)
+SDL_DL_FUNC(SDL_GetWindowDisplayIndex)
+SDL_DL_FUNC(SDL_GetWindowFlags)
+SDL_DL_FUNC(SDL_GetWindowFromID)
+SDL_DL_FUNC(SDL_GetWindowGammaRamp)
+SDL_DL_FUNC(SDL_GetWindowGrab)
+SDL_DL_FUNC(SDL_GetWindowID)
+SDL_DL_FUNC(SDL_GetWindowKeyboardGrab)
+SDL_DL_FUNC(SDL_GetWindowOpacity)
+SDL_DL_FUNC(SDL_GetWindowSize)
+SDL_DL_FUNC(SDL_GetWindowSurface)
+SDL_DL_FUNC(SDL_GetWindowTitle)
+SDL_DL_FUNC(SDL_GetWindowWMInfo)
+SDL_DL_FUNC(SDL_HasColorKey)
+SDL_DL_FUNC(SDL_HasIntersection)
+SDL_DL_FUNC(SDL_HasIntersectionF)
+SDL_DL_FUNC(SDL_HasSurfaceRLE)
+SDL_DL_FUNC(SDL_HideWindow)
+SDL_DL_FUNC(SDL_Init)
+SDL_DL_FUNC(SDL_InitSubSystem)
+SDL_DL_FUNC(SDL_IntersectFRect)
+SDL_DL_FUNC(SDL_IntersectFRectAndLine)
+SDL_DL_FUNC(SDL_IntersectRect)
+SDL_DL_FUNC(SDL_IntersectRectAndLine)
+SDL_DL_FUNC(SDL_IsGameController)
+SDL_DL_FUNC(SDL_IsScreenSaverEnabled)
+SDL_DL_FUNC(SDL_IsShapedWindow)
+SDL_DL_FUNC(SDL_IsTextInputActive)
+SDL_DL_FUNC(SDL_JoystickClose)
+SDL_DL_FUNC(SDL_JoystickEventState)
+SDL_DL_FUNC(SDL_JoystickGetAxis)
+SDL_DL_FUNC(SDL_JoystickGetBall)
+SDL_DL_FUNC(SDL_JoystickGetButton)
+SDL_DL_FUNC(SDL_JoystickGetDeviceInstanceID)
+SDL_DL_FUNC(SDL_JoystickGetGUID)
+SDL_DL_FUNC(SDL_JoystickGetGUIDString)
+SDL_DL_FUNC(SDL_JoystickGetHat)
+SDL_DL_FUNC(SDL_JoystickInstanceID)
+SDL_DL_FUNC(SDL_JoystickName)
+SDL_DL_FUNC(SDL_JoystickNumAxes)
+SDL_DL_FUNC(SDL_JoystickNumBalls)
+SDL_DL_FUNC(SDL_JoystickNumButtons)
+SDL_DL_FUNC(SDL_JoystickNumHats)
+SDL_DL_FUNC(SDL_JoystickOpen)
+SDL_DL_FUNC(SDL_JoystickRumble)
+SDL_DL_FUNC(SDL_JoystickUpdate)
+SDL_DL_FUNC(SDL_LoadBMP_RW)
+SDL_DL_FUNC(SDL_LockAudio)
+SDL_DL_FUNC(SDL_LockSurface)
+SDL_DL_FUNC(SDL_LockTexture)
+SDL_DL_FUNC(SDL_LogGetPriority)
+SDL_DL_FUNC(SDL_LogMessage)
+SDL_DL_FUNC(SDL_LogSetPriority)
+SDL_DL_FUNC(SDL_LowerBlit)
+SDL_DL_FUNC(SDL_LowerBlitScaled)
+SDL_DL_FUNC(SDL_MapRGB)
+SDL_DL_FUNC(SDL_MapRGBA)
+SDL_DL_FUNC(SDL_MasksToPixelFormatEnum)
+SDL_DL_FUNC(SDL_Metal_CreateView)
+SDL_DL_FUNC(SDL_Metal_DestroyView)
+SDL_DL_FUNC(SDL_Metal_GetDrawableSize)
+SDL_DL_FUNC(SDL_Metal_GetLayer)
+SDL_DL_FUNC(SDL_NumJoysticks)
+SDL_DL_FUNC(SDL_OpenAudio)
+SDL_DL_FUNC(SDL_PauseAudio)
+SDL_DL_FUNC(SDL_PixelFormatEnumToMasks)
+SDL_DL_FUNC(SDL_PollEvent)
+SDL_DL_FUNC(SDL_PremultiplyAlpha)
+SDL_DL_FUNC(SDL_PushEvent)
+SDL_DL_FUNC(SDL_QueryTexture)
+SDL_DL_FUNC(SDL_Quit)
+SDL_DL_FUNC(SDL_QuitSubSystem)
+SDL_DL_FUNC(SDL_RWFromConstMem)
+SDL_DL_FUNC(SDL_RWFromFile)
+SDL_DL_FUNC(SDL_RaiseWindow)
+SDL_DL_FUNC(SDL_RenderClear)
+SDL_DL_FUNC(SDL_RenderCopy)
+SDL_DL_FUNC(SDL_RenderCopyEx)
+SDL_DL_FUNC(SDL_RenderCopyExF)
+SDL_DL_FUNC(SDL_RenderCopyF)
+SDL_DL_FUNC(SDL_RenderDrawLine)
+SDL_DL_FUNC(SDL_RenderDrawLineF)
+SDL_DL_FUNC(SDL_RenderDrawLines)
+SDL_DL_FUNC(SDL_RenderDrawLinesF)
+SDL_DL_FUNC(SDL_RenderDrawPoint)
+SDL_DL_FUNC(SDL_RenderDrawPointF)
+SDL_DL_FUNC(SDL_RenderDrawPoints)
+SDL_DL_FUNC(SDL_RenderDrawPointsF)
+SDL_DL_FUNC(SDL_RenderDrawRect)
+SDL_DL_FUNC(SDL_RenderDrawRectF)
+SDL_DL_FUNC(SDL_RenderDrawRects)
+SDL_DL_FUNC(SDL_RenderDrawRectsF)
+SDL_DL_FUNC(SDL_RenderFillRect)
+SDL_DL_FUNC(SDL_RenderFillRectF)
+SDL_DL_FUNC(SDL_RenderFillRects)
+SDL_DL_FUNC(SDL_RenderFillRectsF)
+SDL_DL_FUNC(SDL_RenderFlush)
+SDL_DL_FUNC(SDL_RenderGeometry)
+SDL_DL_FUNC(SDL_RenderGeometryRaw)
+SDL_DL_FUNC(SDL_RenderGetClipRect)
+SDL_DL_FUNC(SDL_RenderGetD3D11Device)
+SDL_DL_FUNC(SDL_RenderGetD3D9Device)
+SDL_DL_FUNC(SDL_RenderGetIntegerScale)
+SDL_DL_FUNC(SDL_RenderGetLogicalSize)
+SDL_DL_FUNC(SDL_RenderGetMetalCommandEncoder)
+SDL_DL_FUNC(SDL_RenderGetMetalLayer)
+SDL_DL_FUNC(SDL_RenderGetScale)
+SDL_DL_FUNC(SDL_RenderGetViewport)
+SDL_DL_FUNC(SDL_RenderGetWindow)
+SDL_DL_FUNC(SDL_RenderIsClipEnabled)
+SDL_DL_FUNC(SDL_RenderLogicalToWindow)
+SDL_DL_FUNC(SDL_RenderPresent)
+SDL_DL_FUNC(SDL_RenderReadPixels)
+SDL_DL_FUNC(SDL_RenderSetClipRect)
+SDL_DL_FUNC(SDL_RenderSetIntegerScale)
+SDL_DL_FUNC(SDL_RenderSetLogicalSize)
+SDL_DL_FUNC(SDL_RenderSetVSync)
+SDL_DL_FUNC(SDL_RenderSetViewport)
+SDL_DL_FUNC(SDL_RenderTargetSupported)
+SDL_DL_FUNC(SDL_RenderWindowToLogical)
+SDL_DL_FUNC(SDL_SaveBMP_RW)
+SDL_DL_FUNC(SDL_SetClipRect)
+SDL_DL_FUNC(SDL_SetColorKey)
+SDL_DL_FUNC(SDL_SetError)
+SDL_DL_FUNC(SDL_SetModState)
+SDL_DL_FUNC(SDL_SetPaletteColors)
+SDL_DL_FUNC(SDL_SetRenderDrawBlendMode)
+SDL_DL_FUNC(SDL_SetRenderDrawColor)
+SDL_DL_FUNC(SDL_SetRenderTarget)
+SDL_DL_FUNC(SDL_SetSurfaceAlphaMod)
+SDL_DL_FUNC(SDL_SetSurfaceBlendMode)
+SDL_DL_FUNC(SDL_SetSurfaceColorMod)
+SDL_DL_FUNC(SDL_SetSurfacePalette)
+SDL_DL_FUNC(SDL_SetSurfaceRLE)
+SDL_DL_FUNC(SDL_SetTextInputRect)
+SDL_DL_FUNC(SDL_SetTextureAlphaMod)
+SDL_DL_FUNC(SDL_SetTextureBlendMode)
+SDL_DL_FUNC(SDL_SetTextureColorMod)
+SDL_DL_FUNC(SDL_SetTextureScaleMode)
+SDL_DL_FUNC(SDL_SetWindowAlwaysOnTop)
+SDL_DL_FUNC(SDL_SetWindowBrightness)
+SDL_DL_FUNC(SDL_SetWindowFullscreen)
+SDL_DL_FUNC(SDL_SetWindowGammaRamp)
+SDL_DL_FUNC(SDL_SetWindowGrab)
+SDL_DL_FUNC(SDL_SetWindowHitTest)
+SDL_DL_FUNC(SDL_SetWindowIcon)
+SDL_DL_FUNC(SDL_SetWindowInputFocus)
+SDL_DL_FUNC(SDL_SetWindowKeyboardGrab)
+SDL_DL_FUNC(SDL_SetWindowModalFor)
+SDL_DL_FUNC(SDL_SetWindowOpacity)
+SDL_DL_FUNC(SDL_SetWindowShape)
+SDL_DL_FUNC(SDL_SetWindowTitle)
+SDL_DL_FUNC(SDL_ShowCursor)
+SDL_DL_FUNC(SDL_ShowMessageBox)
+SDL_DL_FUNC(SDL_ShowSimpleMessageBox)
+SDL_DL_FUNC(SDL_ShowWindow)
+SDL_DL_FUNC(SDL_SoftStretch)
+SDL_DL_FUNC(SDL_SoftStretchLinear)
+SDL_DL_FUNC(SDL_StartTextInput)
+SDL_DL_FUNC(SDL_StopTextInput)
+SDL_DL_FUNC(SDL_UnionFRect)
+SDL_DL_FUNC(SDL_UnionRect)
+SDL_DL_FUNC(SDL_UnlockAudio)
+SDL_DL_FUNC(SDL_UnlockSurface)
+SDL_DL_FUNC(SDL_UnlockTexture)
+SDL_DL_FUNC(SDL_UpdateNVTexture)
+SDL_DL_FUNC(SDL_UpdateTexture)
+SDL_DL_FUNC(SDL_UpdateWindowSurface)
+SDL_DL_FUNC(SDL_UpdateWindowSurfaceRects)
+SDL_DL_FUNC(SDL_UpdateYUVTexture)
+SDL_DL_FUNC(SDL_UpperBlit)
+SDL_DL_FUNC(SDL_UpperBlitScaled)
+SDL_DL_FUNC(SDL_WasInit)
+SDL_DL_FUNC(SDL_free)
+SDL_DL_FUNC(SDL_strlcpy)
+
+IMG_DL_FUNC(IMG_Linked_Version)
+IMG_DL_FUNC(IMG_Load)
+IMG_DL_FUNC(IMG_LoadTexture)
+IMG_DL_FUNC(IMG_LoadTextureTyped_RW)
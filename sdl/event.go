@@ -6,9 +6,12 @@ var events chan interface{} = make(chan interface{})
 
 // This channel delivers SDL events. Each object received from this channel
 // has one of the following types: sdl.QuitEvent, sdl.KeyboardEvent,
-// sdl.MouseButtonEvent, sdl.MouseMotionEvent, sdl.ActiveEvent,
-// sdl.ResizeEvent, sdl.JoyAxisEvent, sdl.JoyButtonEvent, sdl.JoyHatEvent,
-// sdl.JoyBallEvent
+// sdl.TextEditingEvent, sdl.TextInputEvent,
+// sdl.MouseButtonEvent, sdl.MouseMotionEvent, sdl.MouseWheelEvent,
+// sdl.TouchFingerEvent, sdl.WindowEvent, sdl.RenderEvent,
+// sdl.JoyAxisEvent, sdl.JoyButtonEvent, sdl.JoyHatEvent, sdl.JoyBallEvent,
+// sdl.JoyDeviceEvent, sdl.ControllerAxisEvent, sdl.ControllerButtonEvent,
+// sdl.ControllerDeviceEvent
 var Events <-chan interface{} = events
 
 // Polling interval, in milliseconds
@@ -30,12 +33,30 @@ func pollEvents() {
 			case KEYDOWN, KEYUP:
 				events <- *(*KeyboardEvent)(cast(event))
 
+			case TEXTEDITING:
+				events <- *(*TextEditingEvent)(cast(event))
+
+			case TEXTINPUT:
+				events <- *(*TextInputEvent)(cast(event))
+
 			case MOUSEBUTTONDOWN, MOUSEBUTTONUP:
 				events <- *(*MouseButtonEvent)(cast(event))
 
 			case MOUSEMOTION:
 				events <- *(*MouseMotionEvent)(cast(event))
 
+			case MOUSEWHEEL:
+				events <- *(*MouseWheelEvent)(cast(event))
+
+			case FINGERDOWN, FINGERUP, FINGERMOTION:
+				events <- *(*TouchFingerEvent)(cast(event))
+
+			case WINDOWEVENT:
+				events <- *(*WindowEvent)(cast(event))
+
+			case RENDER_TARGETS_RESET, RENDER_DEVICE_RESET:
+				events <- *(*RenderEvent)(cast(event))
+
 			case JOYAXISMOTION:
 				events <- *(*JoyAxisEvent)(cast(event))
 
@@ -47,6 +68,18 @@ func pollEvents() {
 
 			case JOYBALLMOTION:
 				events <- *(*JoyBallEvent)(cast(event))
+
+			case JOYDEVICEADDED, JOYDEVICEREMOVED:
+				events <- *(*JoyDeviceEvent)(cast(event))
+
+			case CONTROLLERAXISMOTION:
+				events <- *(*ControllerAxisEvent)(cast(event))
+
+			case CONTROLLERBUTTONDOWN, CONTROLLERBUTTONUP:
+				events <- *(*ControllerButtonEvent)(cast(event))
+
+			case CONTROLLERDEVICEADDED, CONTROLLERDEVICEREMOVED, CONTROLLERDEVICEREMAPPED:
+				events <- *(*ControllerDeviceEvent)(cast(event))
 			}
 		}
 
@@ -0,0 +1,144 @@
+package sdl
+
+import "sync"
+
+// Splits the event stream by device, so that independent parts of an
+// application (eg. a camera controller and a menu system) can each receive
+// only the events they care about without sharing one big switch statement.
+//
+// Once created, the demultiplexer is the only reader of sdl.Events. Each
+// event is delivered to the subscribed channel for its device, or to the
+// Other channel if no device channel applies. Events without a subscriber
+// are dropped. Delivery blocks until the subscriber receives the event, so
+// every subscribed channel has to be drained.
+type EventDemux struct {
+	mutex       sync.Mutex
+	buffer      int
+	keyboard    chan interface{}
+	mouse       chan interface{}
+	controllers map[int32]chan interface{}
+	other       chan interface{}
+}
+
+// Creates a demultiplexer and starts reading from sdl.Events. buffer is
+// the capacity of the channels it creates.
+func NewEventDemux(buffer int) *EventDemux {
+	d := &EventDemux{
+		buffer:      buffer,
+		controllers: make(map[int32]chan interface{}),
+	}
+
+	go d.run()
+
+	return d
+}
+
+// Returns a channel receiving the KeyboardEvents, TextEditingEvents and
+// TextInputEvents.
+func (d *EventDemux) Keyboard() <-chan interface{} {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.keyboard == nil {
+		d.keyboard = make(chan interface{}, d.buffer)
+	}
+	return d.keyboard
+}
+
+// Returns a channel receiving the MouseMotionEvents, MouseButtonEvents and
+// MouseWheelEvents.
+func (d *EventDemux) Mouse() <-chan interface{} {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.mouse == nil {
+		d.mouse = make(chan interface{}, d.buffer)
+	}
+	return d.mouse
+}
+
+// Returns a channel receiving the joystick and game controller events of
+// the device with the given instance ID (the Which field of the events,
+// except for JOYDEVICEADDED and CONTROLLERDEVICEADDED events, where Which
+// is a device index that is mapped to the instance ID).
+func (d *EventDemux) Controller(id int32) <-chan interface{} {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	c, ok := d.controllers[id]
+	if !ok {
+		c = make(chan interface{}, d.buffer)
+		d.controllers[id] = c
+	}
+	return c
+}
+
+// Returns a channel receiving every event not covered by another channel,
+// such as QuitEvent and WindowEvent, as well as device events for which
+// there is no subscriber.
+func (d *EventDemux) Other() <-chan interface{} {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.other == nil {
+		d.other = make(chan interface{}, d.buffer)
+	}
+	return d.other
+}
+
+// Returns the channel an event belongs to, or nil if it has no subscriber.
+func (d *EventDemux) route(event interface{}) chan interface{} {
+	// Device added events carry a device index rather than an instance ID
+	deviceID := int32(-1)
+	switch e := event.(type) {
+	case JoyDeviceEvent:
+		deviceID = e.Which
+		if e.Type == JOYDEVICEADDED {
+			deviceID = JoystickGetDeviceInstanceID(int(e.Which))
+		}
+	case ControllerDeviceEvent:
+		deviceID = e.Which
+		if e.Type == CONTROLLERDEVICEADDED {
+			deviceID = JoystickGetDeviceInstanceID(int(e.Which))
+		}
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var c chan interface{}
+
+	switch e := event.(type) {
+	case KeyboardEvent, TextEditingEvent, TextInputEvent:
+		c = d.keyboard
+	case MouseMotionEvent, MouseButtonEvent, MouseWheelEvent:
+		c = d.mouse
+	case JoyAxisEvent:
+		c = d.controllers[e.Which]
+	case JoyBallEvent:
+		c = d.controllers[e.Which]
+	case JoyHatEvent:
+		c = d.controllers[e.Which]
+	case JoyButtonEvent:
+		c = d.controllers[e.Which]
+	case JoyDeviceEvent, ControllerDeviceEvent:
+		c = d.controllers[deviceID]
+	case ControllerAxisEvent:
+		c = d.controllers[e.Which]
+	case ControllerButtonEvent:
+		c = d.controllers[e.Which]
+	}
+
+	if c == nil {
+		c = d.other
+	}
+	return c
+}
+
+func (d *EventDemux) run() {
+	for event := range Events {
+		if c := d.route(event); c != nil {
+			c <- event
+		}
+	}
+}
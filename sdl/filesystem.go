@@ -0,0 +1,177 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"unsafe"
+)
+
+// ==========
+// Filesystem
+// ==========
+
+// Gets the directory the application was run from, with a trailing path
+// separator. Returns "" if the platform cannot determine it.
+func GetBasePath() string {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	cpath := C.SDL_GetBasePath()
+	if cpath == nil {
+		return ""
+	}
+	defer C.SDL_free(unsafe.Pointer(cpath))
+
+	return C.GoString(cpath)
+}
+
+// Gets the user-and-app-specific directory where files can be written,
+// creating it if necessary. The returned path ends with a path separator.
+// Returns "" on error.
+func GetPrefPath(org, app string) string {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	corg, capp := C.CString(org), C.CString(app)
+	defer C.free(unsafe.Pointer(corg))
+	defer C.free(unsafe.Pointer(capp))
+
+	cpath := C.SDL_GetPrefPath(corg, capp)
+	if cpath == nil {
+		return ""
+	}
+	defer C.SDL_free(unsafe.Pointer(cpath))
+
+	return C.GoString(cpath)
+}
+
+// =========
+// Save data
+// =========
+
+var (
+	// Returned by SaveFile.Read when the file header is not recognized
+	// or the checksum does not match the data.
+	ErrSaveCorrupt = errors.New("sdl: save file is corrupt")
+
+	// Returned by OpenSaveFile when SDL cannot provide a preference directory.
+	ErrNoPrefPath = errors.New("sdl: no preference path available")
+)
+
+// Identifies save files written with a header.
+var saveMagic = [4]byte{'G', 'S', 'A', 'V'}
+
+// Magic, version, data length and CRC-32 of the data.
+const saveHeaderSize = 16
+
+// A file in the application's preference directory which is replaced
+// atomically on every write: the data is written to a temporary file in
+// the same directory which is then renamed over the old file, so a crash
+// mid-write never leaves a truncated save behind.
+type SaveFile struct {
+	Path string
+
+	// Prefix the data with a header holding Version and a CRC-32 of the
+	// data, which Read uses to detect corrupted files.
+	Header bool
+
+	// Written to the header by Write, updated from the header by Read.
+	Version uint32
+}
+
+// Returns a SaveFile called name inside GetPrefPath(org, app).
+// Headers are enabled by default.
+func OpenSaveFile(org, app, name string) (*SaveFile, error) {
+	dir := GetPrefPath(org, app)
+	if dir == "" {
+		return nil, ErrNoPrefPath
+	}
+
+	return &SaveFile{Path: filepath.Join(dir, name), Header: true}, nil
+}
+
+// Atomically replaces the contents of the save file.
+func (f *SaveFile) Write(data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp*")
+	if err != nil {
+		return err
+	}
+
+	// Only has an effect if something below fails
+	defer os.Remove(tmp.Name())
+
+	if f.Header {
+		var header [saveHeaderSize]byte
+		copy(header[0:4], saveMagic[:])
+		binary.LittleEndian.PutUint32(header[4:8], f.Version)
+		binary.LittleEndian.PutUint32(header[8:12], uint32(len(data)))
+		binary.LittleEndian.PutUint32(header[12:16], crc32.ChecksumIEEE(data))
+
+		if _, err = tmp.Write(header[:]); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+// Reads the contents of the save file. If Header is set, the header is
+// verified and stripped, and Version is set to the version it holds.
+func (f *SaveFile) Read() ([]byte, error) {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.Header {
+		return data, nil
+	}
+
+	if len(data) < saveHeaderSize || string(data[0:4]) != string(saveMagic[:]) {
+		return nil, ErrSaveCorrupt
+	}
+
+	version := binary.LittleEndian.Uint32(data[4:8])
+	length := binary.LittleEndian.Uint32(data[8:12])
+	checksum := binary.LittleEndian.Uint32(data[12:16])
+
+	data = data[saveHeaderSize:]
+	if uint32(len(data)) != length || crc32.ChecksumIEEE(data) != checksum {
+		return nil, ErrSaveCorrupt
+	}
+
+	f.Version = version
+	return data, nil
+}
+
+// Checks whether the save file exists.
+func (f *SaveFile) Exists() bool {
+	_, err := os.Stat(f.Path)
+	return err == nil
+}
+
+// Deletes the save file.
+func (f *SaveFile) Remove() error {
+	return os.Remove(f.Path)
+}
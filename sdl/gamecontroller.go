@@ -0,0 +1,87 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "unsafe"
+
+// ==============
+// GameController
+// ==============
+
+// Get the implementation dependent name of a joystick.
+func (joystick *Joystick) Name() string {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.GoString(C.SDL_JoystickName(joystick.cJoystick))
+}
+
+// Get the GUID of a joystick in its ASCII string form, as used
+// by game controller mappings.
+func (joystick *Joystick) GUIDString() string {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var buf [33]C.char
+
+	guid := C.SDL_JoystickGetGUID(joystick.cJoystick)
+	C.SDL_JoystickGetGUIDString(guid, &buf[0], C.int(len(buf)))
+
+	return C.GoString(&buf[0])
+}
+
+// Add support for controllers that SDL is unaware of or to cause an
+// existing controller to have a different binding. The mapping string has
+// the format "GUID,name,mapping", where mapping takes the form
+// "a:b0,b:b1,leftx:a0,...". Returns 1 if a new mapping is added, 0 if an
+// existing mapping is updated and -1 on error.
+func GameControllerAddMapping(mapping string) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	cmapping := C.CString(mapping)
+	defer C.free(unsafe.Pointer(cmapping))
+
+	return int(C.SDL_GameControllerAddMapping(cmapping))
+}
+
+// Checks whether the joystick at the given index is supported by the
+// game controller interface.
+func IsGameController(deviceIndex int) bool {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_IsGameController(C.int(deviceIndex)) == C.SDL_TRUE
+}
+
+// Gets the instance ID of an opened joystick, which identifies it in
+// joystick and controller events.
+func (joystick *Joystick) InstanceID() int32 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int32(C.SDL_JoystickInstanceID(joystick.cJoystick))
+}
+
+// Gets the instance ID of the joystick with the given device index, as
+// found in JOYDEVICEADDED and CONTROLLERDEVICEADDED events, without
+// opening it. Returns -1 if the index is invalid.
+func JoystickGetDeviceInstanceID(deviceIndex int) int32 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int32(C.SDL_JoystickGetDeviceInstanceID(C.int(deviceIndex)))
+}
+
+// Starts a rumble effect on the joystick, replacing any effect in
+// progress. lowFrequency and highFrequency are the intensities of the two
+// motors, from 0 to 0xFFFF; the effect stops after durationMs milliseconds.
+// Returns 0 on success or -1 if rumble isn't supported.
+func (joystick *Joystick) Rumble(lowFrequency, highFrequency uint16, durationMs uint32) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_JoystickRumble(joystick.cJoystick, C.Uint16(lowFrequency),
+		C.Uint16(highFrequency), C.Uint32(durationMs)))
+}
@@ -0,0 +1,147 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// A vertex of a triangle drawn with Renderer.Geometry: its position in
+// the rendering target, its color, and its position in the texture, from
+// 0, 0 (top left) to 1, 1 (bottom right).
+type Vertex struct {
+	Position FPoint
+	Color    Color
+	TexCoord FPoint
+}
+
+// Draws triangles, optionally textured with t (which may be nil). Without
+// indices, every three vertices form a triangle; otherwise every three
+// indices into vertices do. The vertex colors are interpolated across the
+// triangles and multiplied with the texture.
+func (r *Renderer) Geometry(t *Texture, vertices []Vertex, indices []int32) error {
+	defer lockThread()()
+
+	if len(vertices) == 0 {
+		return nil
+	}
+
+	cvertices := make([]C.SDL_Vertex, len(vertices))
+	for i, v := range vertices {
+		cvertices[i].position = C.SDL_FPoint{C.float(v.Position.X), C.float(v.Position.Y)}
+		cvertices[i].color = C.SDL_Color{C.Uint8(v.Color.R), C.Uint8(v.Color.G), C.Uint8(v.Color.B), C.Uint8(v.Color.Alpha)}
+		cvertices[i].tex_coord = C.SDL_FPoint{C.float(v.TexCoord.X), C.float(v.TexCoord.Y)}
+	}
+
+	var cindices *C.int
+	if len(indices) > 0 {
+		cindices = (*C.int)(cast(&indices[0]))
+	}
+
+	if err := r.tryAcquire(); err != nil {
+		return err
+	}
+	defer r.mutex.Unlock()
+
+	if C.SDL_RenderGeometry(r.cRenderer, textureOrNil(t),
+		&cvertices[0], C.int(len(cvertices)), cindices, C.int(len(indices))) != 0 {
+		return sdlError()
+	}
+
+	return nil
+}
+
+// Draws triangles from vertex attributes stored in separate, possibly
+// interleaved, arrays, avoiding any conversion; this suits vertex buffers
+// produced by other libraries such as Dear ImGui.
+//
+// xy holds the positions and uv the texture coordinates as pairs of
+// floats, and colors holds R, G, B, A bytes; each stride is the distance
+// between consecutive vertices in bytes, counted from the start of the
+// respective slice. uv may be nil if t is nil. indices is nil or a
+// []uint8, []uint16, []uint32 or []int32.
+//
+// Returns an error, without drawing anything, if the slices are too short
+// for numVertices vertices or an index is out of range.
+func (r *Renderer) GeometryRaw(t *Texture, xy []float32, xyStride int, colors []byte, colorStride int,
+	uv []float32, uvStride int, numVertices int, indices interface{}) error {
+	defer lockThread()()
+
+	if numVertices == 0 {
+		return nil
+	}
+
+	if numVertices < 0 || !fitsStride(numVertices, xyStride, 8, len(xy)*4) {
+		return errors.New("sdl: xy too short for the number of vertices")
+	}
+	if !fitsStride(numVertices, colorStride, 4, len(colors)) {
+		return errors.New("sdl: colors too short for the number of vertices")
+	}
+	if (t != nil || len(uv) > 0) && !fitsStride(numVertices, uvStride, 8, len(uv)*4) {
+		return errors.New("sdl: uv too short for the number of vertices")
+	}
+
+	var cindices unsafe.Pointer
+	var numIndices, indexSize int
+	var index func(k int) int64
+	switch i := indices.(type) {
+	case []uint8:
+		numIndices, indexSize = len(i), 1
+		index = func(k int) int64 { return int64(i[k]) }
+	case []uint16:
+		numIndices, indexSize = len(i), 2
+		index = func(k int) int64 { return int64(i[k]) }
+	case []uint32:
+		numIndices, indexSize = len(i), 4
+		index = func(k int) int64 { return int64(i[k]) }
+	case []int32:
+		numIndices, indexSize = len(i), 4
+		index = func(k int) int64 { return int64(i[k]) }
+	case nil:
+	default:
+		return errors.New("sdl: indices must be a []uint8, []uint16, []uint32 or []int32")
+	}
+	for k := 0; k < numIndices; k++ {
+		if v := index(k); v < 0 || v >= int64(numVertices) {
+			return errors.New("sdl: index out of range")
+		}
+	}
+	if numIndices > 0 {
+		cindices = ptr(indices)
+	}
+
+	var cuv *C.float
+	if len(uv) > 0 {
+		cuv = (*C.float)(cast(&uv[0]))
+	}
+
+	if err := r.tryAcquire(); err != nil {
+		return err
+	}
+	defer r.mutex.Unlock()
+
+	if C.SDL_RenderGeometryRaw(r.cRenderer, textureOrNil(t),
+		(*C.float)(cast(&xy[0])), C.int(xyStride),
+		(*C.SDL_Color)(cast(&colors[0])), C.int(colorStride),
+		cuv, C.int(uvStride), C.int(numVertices),
+		cindices, C.int(numIndices), C.int(indexSize)) != 0 {
+		return sdlError()
+	}
+
+	return nil
+}
+
+// Checks that n items of size bytes, stride bytes apart, fit in length
+// bytes.
+func fitsStride(n, stride, size, length int) bool {
+	return stride >= 0 && (n-1)*stride+size <= length
+}
+
+func textureOrNil(t *Texture) *C.SDL_Texture {
+	if t == nil {
+		return nil
+	}
+	return t.handle()
+}
@@ -0,0 +1,130 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The largest number of samples per pixel GLConfig accepts.
+const maxMultisampleSamples = 32
+
+// OpenGL attributes applied before creating a window with
+// CreateGLWindow. Zero fields leave SDL's defaults in place.
+type GLConfig struct {
+	RedSize, GreenSize, BlueSize, AlphaSize int
+	DepthSize, StencilSize                  int
+	DoubleBuffer                            bool
+
+	// Multi-sample anti-aliasing. MultisampleBuffers is 0 or 1 and
+	// MultisampleSamples a power of two; setting only MultisampleSamples
+	// implies one buffer.
+	MultisampleBuffers int
+	MultisampleSamples int
+}
+
+// Checks that the multisample settings are values OpenGL can honour.
+func (c *GLConfig) Validate() error {
+	if c.MultisampleBuffers < 0 || c.MultisampleBuffers > 1 {
+		return fmt.Errorf("sdl: invalid multisample buffer count %d, must be 0 or 1", c.MultisampleBuffers)
+	}
+
+	s := c.MultisampleSamples
+	if s < 0 || s > maxMultisampleSamples || s&(s-1) != 0 || s == 1 {
+		return fmt.Errorf("sdl: invalid multisample sample count %d, must be 0 or a power of two up to %d",
+			s, maxMultisampleSamples)
+	}
+
+	if c.MultisampleBuffers == 1 && s == 0 {
+		return errors.New("sdl: multisample buffer requested without samples")
+	}
+
+	return nil
+}
+
+// Sets the GL attributes described by the configuration.
+func (c *GLConfig) Apply() error {
+	defer lockThread()()
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	buffers := c.MultisampleBuffers
+	if c.MultisampleSamples > 0 {
+		buffers = 1
+	}
+
+	attributes := []struct{ attr, value int }{
+		{GL_RED_SIZE, c.RedSize},
+		{GL_GREEN_SIZE, c.GreenSize},
+		{GL_BLUE_SIZE, c.BlueSize},
+		{GL_ALPHA_SIZE, c.AlphaSize},
+		{GL_DEPTH_SIZE, c.DepthSize},
+		{GL_STENCIL_SIZE, c.StencilSize},
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	for _, a := range attributes {
+		if a.value == 0 {
+			continue
+		}
+		if C.SDL_GL_SetAttribute(C.SDL_GLattr(a.attr), C.int(a.value)) != 0 {
+			return sdlError()
+		}
+	}
+
+	if c.DoubleBuffer {
+		if C.SDL_GL_SetAttribute(C.SDL_GL_DOUBLEBUFFER, 1) != 0 {
+			return sdlError()
+		}
+	}
+
+	// Always set, so a failed attempt with more samples doesn't linger
+	if C.SDL_GL_SetAttribute(C.SDL_GL_MULTISAMPLEBUFFERS, C.int(buffers)) != 0 ||
+		C.SDL_GL_SetAttribute(C.SDL_GL_MULTISAMPLESAMPLES, C.int(c.MultisampleSamples)) != 0 {
+		return sdlError()
+	}
+
+	return nil
+}
+
+// Creates an OpenGL window using the configuration. If the requested
+// number of samples isn't supported, creation is retried with half as many
+// until it succeeds or anti-aliasing is off; MultisampleSamples is then
+// updated to the number of samples the window was created with.
+func CreateGLWindow(title string, x, y, w, h int, flags uint32, c *GLConfig) (*Window, error) {
+	defer lockThread()()
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	for {
+		if err := c.Apply(); err != nil {
+			return nil, err
+		}
+
+		window := CreateWindow(title, x, y, w, h, flags|WINDOW_OPENGL)
+		if window != nil {
+			return window, nil
+		}
+
+		if c.MultisampleSamples == 0 {
+			GlobalMutex.Lock()
+			defer GlobalMutex.Unlock()
+
+			return nil, sdlError()
+		}
+
+		c.MultisampleSamples /= 2
+		if c.MultisampleSamples < 2 {
+			c.MultisampleSamples = 0
+			c.MultisampleBuffers = 0
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "errors"
+
+// Reported when a Renderer, Texture or Surface is used after being
+// destroyed (or freed), or through a nil pointer. Methods returning an
+// error return it; other methods panic with an error matching it, so that
+// such a bug fails with a clear message instead of a crash inside SDL.
+//
+// A texture is destroyed along with its renderer. Destroying a texture
+// only marks the *Texture it was called on, other wrappers of the same
+// texture (such as one returned by Renderer.GetRenderTarget) are not
+// tracked.
+var ErrDestroyed = errors.New("sdl: use of a destroyed handle")
+
+// The kind of handle that was used after being destroyed.
+type destroyedError string
+
+func (e destroyedError) Error() string {
+	return "sdl: use of a destroyed or nil " + string(e)
+}
+
+func (e destroyedError) Is(target error) bool {
+	return target == ErrDestroyed
+}
+
+// Locks the renderer, or returns ErrDestroyed without locking it.
+func (r *Renderer) tryAcquire() error {
+	if r == nil || r.shared == nil {
+		return destroyedError("renderer")
+	}
+
+	r.mutex.Lock()
+	if r.shared.destroyed {
+		r.mutex.Unlock()
+		return destroyedError("renderer")
+	}
+
+	return nil
+}
+
+// Locks the renderer, panicking if it has been destroyed.
+func (r *Renderer) acquire() {
+	if err := r.tryAcquire(); err != nil {
+		panic(err)
+	}
+}
+
+// Takes GlobalMutex and then the renderer's lock, for calls that also
+// touch the renderer's window or the video backend. Returns ErrDestroyed
+// without locking anything if the renderer has been destroyed.
+func (r *Renderer) tryAcquireWindow() error {
+	GlobalMutex.Lock()
+	if err := r.tryAcquire(); err != nil {
+		GlobalMutex.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Like tryAcquireWindow, but panics if the renderer has been destroyed.
+func (r *Renderer) acquireWindow() {
+	if err := r.tryAcquireWindow(); err != nil {
+		panic(err)
+	}
+}
+
+// Releases the locks taken by acquireWindow or tryAcquireWindow.
+func (r *Renderer) releaseWindow() {
+	r.mutex.Unlock()
+	GlobalMutex.Unlock()
+}
+
+// Locks the texture's renderer, or returns ErrDestroyed without locking it.
+func (t *Texture) tryAcquire() error {
+	if t == nil || t.renderer == nil {
+		return destroyedError("texture")
+	}
+
+	t.mutex.Lock()
+	if t.cTexture == nil || t.renderer.destroyed {
+		t.mutex.Unlock()
+		return destroyedError("texture")
+	}
+
+	return nil
+}
+
+// Locks the texture's renderer, panicking if the texture has been
+// destroyed.
+func (t *Texture) acquire() {
+	if err := t.tryAcquire(); err != nil {
+		panic(err)
+	}
+}
+
+// Gets the SDL texture for passing to a renderer function, panicking if
+// the texture has been destroyed. The caller holds the renderer's lock.
+func (t *Texture) handle() *C.SDL_Texture {
+	if t == nil || t.cTexture == nil || t.renderer.destroyed {
+		panic(destroyedError("texture"))
+	}
+
+	return t.cTexture
+}
+
+// Panics if the surface has been freed.
+func (s *Surface) check() {
+	if s == nil || s.cSurface == nil {
+		panic(destroyedError("surface"))
+	}
+}
@@ -0,0 +1,70 @@
+package sdl
+
+// #include "callbacks.h"
+import "C"
+
+import "sync"
+
+// Decides which part of a borderless window lies under the point x,y
+// (in window coordinates). Must return one of the HITTEST_* constants.
+//
+// The function is called while SDL is processing events, so it must be
+// fast and must not call other functions of this package. If it panics,
+// the point is treated as HITTEST_NORMAL (see SetCallbackPanicHandler).
+type HitTestFunc func(window *Window, x, y int) int
+
+type hitTest struct {
+	window   *Window
+	callback HitTestFunc
+}
+
+// Not GlobalMutex: hit tests run inside SDL_PollEvent, which is called
+// with GlobalMutex held.
+var hitTestMutex sync.Mutex
+var hitTests = make(map[*C.SDL_Window]hitTest)
+
+// Installs a callback which declares the draggable and resizable regions
+// of the window, allowing borderless windows to implement their own title
+// bars and borders. Passing nil removes the callback.
+// Returns 0 on success or -1 if hit testing isn't supported.
+func (w *Window) SetHitTest(callback HitTestFunc) int {
+	hitTestMutex.Lock()
+	if callback != nil {
+		hitTests[w.cWindow] = hitTest{w, callback}
+	} else {
+		delete(hitTests, w.cWindow)
+	}
+	hitTestMutex.Unlock()
+
+	enable := C.int(0)
+	if callback != nil {
+		enable = 1
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.callbacks_setWindowHitTest(w.cWindow, enable))
+}
+
+func (w *Window) removeHitTest() {
+	hitTestMutex.Lock()
+	delete(hitTests, w.cWindow)
+	hitTestMutex.Unlock()
+}
+
+//export goHitTest
+func goHitTest(cWindow *C.SDL_Window, x, y C.int) (result C.int) {
+	result = HITTEST_NORMAL
+	defer recoverCallback("hit test")
+
+	hitTestMutex.Lock()
+	h, ok := hitTests[cWindow]
+	hitTestMutex.Unlock()
+
+	if ok {
+		result = C.int(h.callback(h.window, int(x), int(y)))
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"unsafe"
+)
+
+var errEmptyImage = errors.New("sdl: image is empty")
+
+// Returns the pixels of img as non-premultiplied RGBA, as SDL expects for
+// alpha blending, avoiding a copy where img already is in that form.
+func nrgbaPixels(img image.Image) (pix []byte, stride int) {
+	switch i := img.(type) {
+	case *image.NRGBA:
+		return i.Pix[i.PixOffset(i.Rect.Min.X, i.Rect.Min.Y):], i.Stride
+	case *image.RGBA:
+		// Premultiplied and straight alpha only agree for opaque images
+		if i.Opaque() {
+			return i.Pix[i.PixOffset(i.Rect.Min.X, i.Rect.Min.Y):], i.Stride
+		}
+	}
+
+	b := img.Bounds()
+	n := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(n, n.Rect, img, b.Min, draw.Src)
+
+	return n.Pix, n.Stride
+}
+
+// Creates a static texture with the contents of an image of any type
+// (*image.RGBA, *image.NRGBA, *image.Paletted, ...). Costly conversions
+// are reported to the asset warning handler (see SetAssetWarningHandler).
+func CreateTextureFromImage(r *Renderer, img image.Image) (*Texture, error) {
+	defer lockThread()()
+
+	b := img.Bounds()
+	if b.Empty() {
+		return nil, errEmptyImage
+	}
+
+	checkAsset(r, PIXELFORMAT_RGBA32, b.Dx(), b.Dy())
+
+	t := CreateTexture(r, PIXELFORMAT_RGBA32, TEXTUREACCESS_STATIC, b.Dx(), b.Dy())
+	if t == nil {
+		r.acquire()
+		defer r.mutex.Unlock()
+
+		return nil, sdlError()
+	}
+
+	if err := t.UpdateFromImage(img, nil); err != nil {
+		t.Destroy()
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Replaces an area of the texture with the contents of an image, converted
+// to the texture's format. The area starts at the top left corner of the
+// texture if rect is nil, and has the size of the image either way.
+// Textures in YUV formats are not supported.
+func (t *Texture) UpdateFromImage(img image.Image, rect *Rect) error {
+	defer lockThread()()
+
+	b := img.Bounds()
+	if b.Empty() {
+		return errEmptyImage
+	}
+
+	area := Rect{0, 0, int32(b.Dx()), int32(b.Dy())}
+	if rect != nil {
+		area.X, area.Y = rect.X, rect.Y
+	}
+
+	format, _, _, _, err := t.Query()
+	if err != nil {
+		return err
+	}
+
+	// YUV and other FourCC formats have no fixed number of bytes per pixel
+	if bytesPerPixel(format) == 0 || (format>>28)&0x0F != 1 {
+		return errors.New("sdl: cannot update a " + pixelFormatName(format) + " texture from an image")
+	}
+
+	pix, stride := nrgbaPixels(img)
+
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	if format == PIXELFORMAT_RGBA32 {
+		if C.SDL_UpdateTexture(t.cTexture, (*C.SDL_Rect)(cast(&area)), unsafe.Pointer(&pix[0]), C.int(stride)) != 0 {
+			return sdlError()
+		}
+		return nil
+	}
+
+	// Convert to the texture's format first
+	pitch := int(area.W) * bytesPerPixel(format)
+	converted := make([]byte, pitch*int(area.H))
+	if C.SDL_ConvertPixels(C.int(area.W), C.int(area.H),
+		C.Uint32(PIXELFORMAT_RGBA32), unsafe.Pointer(&pix[0]), C.int(stride),
+		C.Uint32(format), unsafe.Pointer(&converted[0]), C.int(pitch)) != 0 {
+		return sdlError()
+	}
+
+	if C.SDL_UpdateTexture(t.cTexture, (*C.SDL_Rect)(cast(&area)), unsafe.Pointer(&converted[0]), C.int(pitch)) != 0 {
+		return sdlError()
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package sdl
+
+import (
+	"math"
+	"time"
+)
+
+// Default values of the KineticScroller settings.
+const (
+	defaultWheelStep    = 60
+	defaultDeceleration = 325 * time.Millisecond
+	defaultOverscroll   = 120
+)
+
+// How quickly an overscrolled view springs back into its range.
+const springBack = 150 * time.Millisecond
+
+// Releasing a finger that has rested this long does not fling the view.
+const flingTimeout = 100 * time.Millisecond
+
+// Scroll physics for a view larger than the area showing it: mouse wheel
+// and touch events are turned into a scroll offset which keeps moving
+// after the wheel stops or the finger is lifted, slows down with friction
+// and springs back when it has been pulled past the ends of the content.
+//
+// Pass every event to HandleEvent and call Update once per frame:
+//
+//	for e := range events {
+//		scroller.HandleEvent(e)
+//	}
+//	x, y := scroller.Update(dt)
+//	// draw the content moved up by y and left by x
+type KineticScroller struct {
+	// The range of the offset, usually 0 to the content size minus the
+	// view size.
+	MinX, MaxX float64
+	MinY, MaxY float64
+
+	// Size of the view in pixels. Touch movement is reported relative to
+	// the window, so ViewW and ViewH should be the window size for
+	// full-window views.
+	ViewW, ViewH float64
+
+	// Distance scrolled by one wheel notch, defaults to 60 pixels.
+	WheelStep float64
+
+	// Time for the velocity to fall to about a third after a fling,
+	// defaults to 325ms.
+	Deceleration time.Duration
+
+	// How far the offset can be pulled past the range, defaults to 120
+	// pixels. A negative value disables rubber-banding.
+	Overscroll float64
+
+	x, y kineticAxis
+
+	dragging   bool
+	finger     int64
+	lastMotion time.Time
+}
+
+type kineticAxis struct {
+	offset   float64
+	velocity float64 // Pixels per second
+}
+
+// Creates a scroller for a view of the given size showing content of
+// the given size. The offset starts at 0, 0.
+func NewKineticScroller(viewW, viewH, contentW, contentH float64) *KineticScroller {
+	return &KineticScroller{
+		MaxX:  math.Max(0, contentW-viewW),
+		MaxY:  math.Max(0, contentH-viewH),
+		ViewW: viewW,
+		ViewH: viewH,
+	}
+}
+
+// Returns the current scroll offset.
+func (k *KineticScroller) Offset() (x, y float64) {
+	return k.x.offset, k.y.offset
+}
+
+// Moves the view to an offset and stops any motion.
+func (k *KineticScroller) SetOffset(x, y float64) {
+	k.x = kineticAxis{offset: x}
+	k.y = kineticAxis{offset: y}
+}
+
+// Checks whether the view is still moving, in which case Update should
+// keep being called every frame.
+func (k *KineticScroller) Moving() bool {
+	return k.dragging || k.x.moving(k.MinX, k.MaxX) || k.y.moving(k.MinY, k.MaxY)
+}
+
+// Updates the scroller from a MouseWheelEvent or TouchFingerEvent. Other
+// events are ignored, so it is safe to pass every event received from
+// sdl.Events.
+func (k *KineticScroller) HandleEvent(event interface{}) {
+	switch e := event.(type) {
+	case MouseWheelEvent:
+		dx, dy := float64(e.X), float64(e.Y)
+		if e.PreciseX != 0 || e.PreciseY != 0 {
+			dx, dy = float64(e.PreciseX), float64(e.PreciseY)
+		}
+		if e.Direction == MOUSEWHEEL_FLIPPED {
+			dx, dy = -dx, -dy
+		}
+
+		// With exponential decay, the distance travelled is the initial
+		// velocity times the time constant. Wheel Y is positive when
+		// scrolling up, towards the start of the content.
+		tau := k.deceleration().Seconds()
+		k.x.velocity += dx * k.wheelStep() / tau
+		k.y.velocity -= dy * k.wheelStep() / tau
+
+	case TouchFingerEvent:
+		switch e.Type {
+		case FINGERDOWN:
+			if k.dragging {
+				return
+			}
+			k.dragging = true
+			k.finger = e.FingerId
+			k.lastMotion = time.Now()
+			k.x.velocity, k.y.velocity = 0, 0
+
+		case FINGERMOTION:
+			if !k.dragging || e.FingerId != k.finger {
+				return
+			}
+
+			now := time.Now()
+			dt := now.Sub(k.lastMotion).Seconds()
+			k.lastMotion = now
+
+			// The content follows the finger
+			k.x.drag(-float64(e.Dx)*k.ViewW, dt, k.MinX, k.MaxX, k.overscroll())
+			k.y.drag(-float64(e.Dy)*k.ViewH, dt, k.MinY, k.MaxY, k.overscroll())
+
+		case FINGERUP:
+			if !k.dragging || e.FingerId != k.finger {
+				return
+			}
+			k.dragging = false
+
+			if time.Since(k.lastMotion) > flingTimeout {
+				k.x.velocity, k.y.velocity = 0, 0
+			}
+		}
+	}
+}
+
+// Advances the motion by dt, the time since the previous frame, and
+// returns the new offset.
+func (k *KineticScroller) Update(dt time.Duration) (x, y float64) {
+	if !k.dragging {
+		tau := k.deceleration().Seconds()
+		k.x.step(dt.Seconds(), tau, k.MinX, k.MaxX, k.overscroll())
+		k.y.step(dt.Seconds(), tau, k.MinY, k.MaxY, k.overscroll())
+	}
+
+	return k.x.offset, k.y.offset
+}
+
+func (k *KineticScroller) wheelStep() float64 {
+	if k.WheelStep <= 0 {
+		return defaultWheelStep
+	}
+	return k.WheelStep
+}
+
+func (k *KineticScroller) deceleration() time.Duration {
+	if k.Deceleration <= 0 {
+		return defaultDeceleration
+	}
+	return k.Deceleration
+}
+
+func (k *KineticScroller) overscroll() float64 {
+	if k.Overscroll < 0 {
+		return 0
+	}
+	if k.Overscroll == 0 {
+		return defaultOverscroll
+	}
+	return k.Overscroll
+}
+
+// Moves the axis by delta, with increasing resistance the further it is
+// pulled out of range, and tracks the velocity of the movement.
+func (a *kineticAxis) drag(delta, dt, lo, hi, overscroll float64) {
+	if over := a.overscrolled(lo, hi); over != 0 && overscroll > 0 && (over < 0) == (delta < 0) {
+		delta *= 0.5 * math.Max(0, 1-math.Abs(over)/overscroll)
+	}
+
+	a.offset = a.clamp(a.offset+delta, lo, hi, overscroll)
+
+	// Smooth the velocity, touch events arrive at irregular intervals
+	if dt > 0 {
+		a.velocity = 0.8*(delta/dt) + 0.2*a.velocity
+	}
+}
+
+// Advances momentum and spring-back by dt seconds.
+func (a *kineticAxis) step(dt, tau, lo, hi, overscroll float64) {
+	decay := math.Exp(-dt / tau)
+	a.offset += a.velocity * tau * (1 - decay)
+	a.velocity *= decay
+
+	if math.Abs(a.velocity) < 1 {
+		a.velocity = 0
+	}
+
+	over := a.overscrolled(lo, hi)
+	if over == 0 {
+		return
+	}
+
+	if overscroll == 0 {
+		a.offset -= over
+		a.velocity = 0
+		return
+	}
+
+	// Out of range the momentum dies quickly and the offset relaxes
+	// towards the end of the range
+	spring := math.Exp(-dt / springBack.Seconds())
+	a.velocity *= spring * spring
+	a.offset = a.clamp(a.offset, lo, hi, overscroll)
+
+	over = a.overscrolled(lo, hi)
+	if math.Abs(over) < 0.5 {
+		a.offset -= over
+	} else if (over > 0) != (a.velocity > 0) || a.velocity == 0 {
+		a.offset -= over * (1 - spring)
+	}
+}
+
+func (a *kineticAxis) moving(lo, hi float64) bool {
+	return a.velocity != 0 || a.overscrolled(lo, hi) != 0
+}
+
+// Returns how far the offset is past hi (positive) or lo (negative).
+func (a *kineticAxis) overscrolled(lo, hi float64) float64 {
+	if hi < lo {
+		hi = lo
+	}
+
+	switch {
+	case a.offset > hi:
+		return a.offset - hi
+	case a.offset < lo:
+		return a.offset - lo
+	}
+	return 0
+}
+
+func (a *kineticAxis) clamp(offset, lo, hi, overscroll float64) float64 {
+	if hi < lo {
+		hi = lo
+	}
+	return math.Max(lo-overscroll, math.Min(offset, hi+overscroll))
+}
@@ -0,0 +1,39 @@
+//go:build sdl_dlopen && linux && !sdl_static
+
+package sdl
+
+// Loads SDL2 and SDL2_image with dlopen when the program starts, instead
+// of linking against them, so that the program can start and report an
+// error when they are not installed (see LoadError). Experimental, and
+// only available on Linux: other systems link against the shared
+// libraries as usual.
+
+// #cgo linux LDFLAGS: -ldl
+// int dlopen_loadSDL(char *err, int size);
+// int dlopen_loadImage(char *err, int size);
+import "C"
+
+import "errors"
+
+// Initialized before any init function runs, as those may call SDL
+var librariesLoaded = loadLibraries()
+
+func loadLibraries() bool {
+	var buf [errorBufferSize]C.char
+
+	if C.dlopen_loadSDL(&buf[0], C.int(len(buf))) != 0 {
+		loadErr = errors.New("sdl: " + C.GoString(&buf[0]))
+		imageLoadErr = loadErr
+		return false
+	}
+
+	if C.dlopen_loadImage(&buf[0], C.int(len(buf))) != 0 {
+		imageLoadErr = errors.New("sdl: " + C.GoString(&buf[0]))
+	}
+
+	return true
+}
+
+func init() {
+	registerFeature("sdl_dlopen")
+}
@@ -0,0 +1,10 @@
+//go:build !sdl_static && !(sdl_dlopen && linux)
+
+package sdl
+
+// Links against the shared SDL2 and SDL2_image found through pkg-config.
+// Build with the sdl_static tag to link them statically instead, or
+// with sdl_dlopen to load them at run time.
+
+// #cgo pkg-config: sdl2 SDL2_image
+import "C"
@@ -0,0 +1,22 @@
+//go:build sdl_static
+
+package sdl
+
+// Links SDL2 and SDL2_image statically, producing a binary that does not need
+// the libraries installed at run time. Requires the static (.a) versions
+// of the libraries.
+//
+// pkg-config --static only adds the private dependencies of the libraries,
+// so the SDL libraries themselves are forced static with -Bstatic. Their
+// dependencies stay dynamic; --as-needed drops the shared SDL libraries
+// that pkg-config names again afterwards. The mixer and ttf packages list
+// SDL2 in their static block too, since their libraries depend on it and
+// may come first on the link line.
+
+// #cgo linux LDFLAGS: -Wl,-Bstatic -lSDL2_image -lSDL2 -Wl,-Bdynamic -Wl,--as-needed
+// #cgo pkg-config: --static sdl2 SDL2_image
+import "C"
+
+func init() {
+	registerFeature("sdl_static")
+}
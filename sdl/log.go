@@ -0,0 +1,66 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+//
+// static void logMessage(int category, SDL_LogPriority priority, const char *message) {
+//	SDL_LogMessage(category, priority, "%s", message);
+// }
+import "C"
+
+import "unsafe"
+
+// =======
+// Logging
+// =======
+
+// Log categories.
+const (
+	LOG_CATEGORY_APPLICATION = C.SDL_LOG_CATEGORY_APPLICATION
+	LOG_CATEGORY_ERROR       = C.SDL_LOG_CATEGORY_ERROR
+	LOG_CATEGORY_ASSERT      = C.SDL_LOG_CATEGORY_ASSERT
+	LOG_CATEGORY_SYSTEM      = C.SDL_LOG_CATEGORY_SYSTEM
+	LOG_CATEGORY_AUDIO       = C.SDL_LOG_CATEGORY_AUDIO
+	LOG_CATEGORY_VIDEO       = C.SDL_LOG_CATEGORY_VIDEO
+	LOG_CATEGORY_RENDER      = C.SDL_LOG_CATEGORY_RENDER
+	LOG_CATEGORY_INPUT       = C.SDL_LOG_CATEGORY_INPUT
+	LOG_CATEGORY_CUSTOM      = C.SDL_LOG_CATEGORY_CUSTOM
+)
+
+// Log priorities.
+const (
+	LOG_PRIORITY_VERBOSE  = C.SDL_LOG_PRIORITY_VERBOSE
+	LOG_PRIORITY_DEBUG    = C.SDL_LOG_PRIORITY_DEBUG
+	LOG_PRIORITY_INFO     = C.SDL_LOG_PRIORITY_INFO
+	LOG_PRIORITY_WARN     = C.SDL_LOG_PRIORITY_WARN
+	LOG_PRIORITY_ERROR    = C.SDL_LOG_PRIORITY_ERROR
+	LOG_PRIORITY_CRITICAL = C.SDL_LOG_PRIORITY_CRITICAL
+)
+
+// Logs a message through SDL's logging, which filters it by the priority
+// set for its category and writes it to the platform's log (stderr on
+// most desktops).
+func LogMessage(category, priority int, message string) {
+	cmessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cmessage))
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.logMessage(C.int(category), C.SDL_LogPriority(priority), cmessage)
+}
+
+// Sets the lowest priority logged for a category.
+func LogSetPriority(category, priority int) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_LogSetPriority(C.int(category), C.SDL_LogPriority(priority))
+}
+
+// Gets the lowest priority logged for a category.
+func LogGetPriority(category int) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_LogGetPriority(C.int(category)))
+}
@@ -0,0 +1,111 @@
+package sdl
+
+import "time"
+
+// How often LowPowerRenderer asks SDL for the power state.
+const powerPollInterval = 5 * time.Second
+
+// Wraps a Renderer so that presenting frames costs less while nobody is
+// looking: while the window is minimized or hidden Present is skipped
+// altogether, and while the window is unfocused (or, if OnBattery is set,
+// while the machine runs on battery) frames are limited to IdleFPS.
+//
+// The renderer learns about the window's state from the WindowEvents
+// passed to HandleEvent.
+type LowPowerRenderer struct {
+	Renderer *Renderer
+
+	// Frame rate cap while throttled, defaults to 10.
+	IdleFPS int
+
+	// Also throttle while running on battery.
+	OnBattery bool
+
+	windowId  uint32
+	focused   bool
+	invisible bool
+
+	battery     bool
+	lastPower   time.Time
+	lastPresent time.Time
+}
+
+// Creates a LowPowerRenderer for a renderer drawing into the given window.
+func NewLowPowerRenderer(r *Renderer, w *Window) *LowPowerRenderer {
+	return &LowPowerRenderer{
+		Renderer: r,
+		IdleFPS:  10,
+		windowId: w.GetID(),
+		focused:  true,
+	}
+}
+
+// Updates the tracked window state. Events that aren't WindowEvents for
+// the renderer's window are ignored, so it is safe to pass every event
+// received from sdl.Events.
+func (l *LowPowerRenderer) HandleEvent(event interface{}) {
+	e, ok := event.(WindowEvent)
+	if !ok || e.WindowId != l.windowId {
+		return
+	}
+
+	switch e.Event {
+	case WINDOWEVENT_FOCUS_GAINED:
+		l.focused = true
+	case WINDOWEVENT_FOCUS_LOST:
+		l.focused = false
+	case WINDOWEVENT_MINIMIZED, WINDOWEVENT_HIDDEN:
+		l.invisible = true
+	case WINDOWEVENT_RESTORED, WINDOWEVENT_SHOWN, WINDOWEVENT_MAXIMIZED, WINDOWEVENT_EXPOSED:
+		l.invisible = false
+	}
+}
+
+// Returns true if nothing rendered would be visible, in which case
+// Present does nothing.
+func (l *LowPowerRenderer) Hidden() bool {
+	return l.invisible
+}
+
+// Returns true if frames are currently limited to IdleFPS.
+func (l *LowPowerRenderer) Throttled() bool {
+	if l.invisible || !l.focused {
+		return true
+	}
+
+	if l.OnBattery {
+		if time.Since(l.lastPower) > powerPollInterval {
+			state, _, _ := GetPowerInfo()
+			l.battery = state == POWERSTATE_ON_BATTERY
+			l.lastPower = time.Now()
+		}
+		return l.battery
+	}
+
+	return false
+}
+
+// Presents the renderer, subject to the low-power rules. While throttled,
+// Present sleeps until the next frame is due, so a render loop calling it
+// is capped to IdleFPS. Returns false if the frame was skipped.
+func (l *LowPowerRenderer) Present() bool {
+	if l.Throttled() {
+		fps := l.IdleFPS
+		if fps <= 0 {
+			fps = 10
+		}
+
+		if wait := time.Second/time.Duration(fps) - time.Since(l.lastPresent); wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+
+	l.lastPresent = time.Now()
+
+	if l.invisible {
+		return false
+	}
+
+	l.Renderer.Present()
+	return true
+}
@@ -0,0 +1,85 @@
+package sdl
+
+// Shows a zoomed copy of the area under the mouse cursor, as used by
+// pixel-art editors and as an accessibility aid.
+//
+// Each frame, after the scene has been drawn and before the renderer is
+// presented, call Update to capture the area around the cursor and Draw to
+// render the enlarged copy on top of the scene. Pixels are enlarged without
+// filtering, so every source pixel becomes a sharp Zoom x Zoom block.
+type Magnifier struct {
+	Size int // Width and height of the captured area, in pixels
+	Zoom int // Enlargement factor
+
+	renderer *Renderer
+	texture  *Texture
+	pixels   []byte
+}
+
+// Creates a magnifier capturing size x size pixels and enlarging them
+// zoom times. Returns nil if its texture cannot be created.
+func NewMagnifier(r *Renderer, size, zoom int) *Magnifier {
+	texture := CreateTexture(r, PIXELFORMAT_ARGB8888, TEXTUREACCESS_STREAMING, size, size)
+	if texture == nil {
+		return nil
+	}
+	texture.SetScaleMode(SCALEMODE_NEAREST)
+
+	return &Magnifier{
+		Size:     size,
+		Zoom:     zoom,
+		renderer: r,
+		texture:  texture,
+		pixels:   make([]byte, size*size*4),
+	}
+}
+
+// Captures the area centered on x,y from the current rendering target.
+// The area is moved inwards where it would extend past the edges of the
+// output. Returns false if the pixels could not be read.
+func (m *Magnifier) Capture(x, y int) bool {
+	w, h := m.renderer.outputSize()
+
+	region := Rect{
+		X: int32(clamp(x-m.Size/2, 0, w-m.Size)),
+		Y: int32(clamp(y-m.Size/2, 0, h-m.Size)),
+		W: int32(m.Size),
+		H: int32(m.Size),
+	}
+
+	if m.renderer.readPixels(&region, PIXELFORMAT_ARGB8888, m.pixels, m.Size*4) != 0 {
+		return false
+	}
+
+	m.texture.Update(nil, m.pixels, m.Size*4)
+	return true
+}
+
+// Captures the area under the mouse cursor.
+func (m *Magnifier) Update() bool {
+	var x, y int
+	GetMouseState(&x, &y)
+
+	return m.Capture(x, y)
+}
+
+// Draws the last captured area, enlarged, with its top-left corner at x,y.
+func (m *Magnifier) Draw(x, y int) {
+	side := int32(m.Size * m.Zoom)
+	m.renderer.Copy(m.texture, nil, &Rect{int32(x), int32(y), side, side})
+}
+
+// Destroys the magnifier's texture.
+func (m *Magnifier) Destroy() {
+	m.texture.Destroy()
+}
+
+func clamp(v, lo, hi int) int {
+	if v > hi {
+		v = hi
+	}
+	if v < lo {
+		v = lo
+	}
+	return v
+}
@@ -0,0 +1,171 @@
+package sdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The standard game controller elements, in the order a MappingWizard
+// prompts for them.
+var MappingElements = []string{
+	"a", "b", "x", "y",
+	"back", "guide", "start",
+	"leftstick", "rightstick",
+	"leftshoulder", "rightshoulder",
+	"dpup", "dpdown", "dpleft", "dpright",
+	"leftx", "lefty", "rightx", "righty",
+	"lefttrigger", "righttrigger",
+}
+
+// How far an axis has to travel from its resting position before the
+// wizard records it.
+const mappingAxisThreshold = 16384
+
+// Builds a game controller mapping for a joystick SDL does not know about.
+//
+// The wizard asks for one standard element at a time (see Prompt), records
+// the first raw button, hat or axis that changes on the joystick, and waits
+// for everything to return to rest before moving on to the next element.
+// A typical loop looks like:
+//
+//	wizard := sdl.NewMappingWizard(joystick)
+//	for !wizard.Done() {
+//		showPrompt(wizard.Prompt())
+//		wizard.Poll()
+//		sdl.Delay(10)
+//	}
+//	wizard.Register()
+type MappingWizard struct {
+	joystick *Joystick
+	current  int
+	bindings map[string]string
+
+	axisRest    []int16
+	waitRelease bool
+}
+
+// Creates a wizard for the given open joystick. All the joystick's inputs
+// should be at rest when this is called, since their current state is used
+// as the baseline for detecting input.
+func NewMappingWizard(joystick *Joystick) *MappingWizard {
+	w := &MappingWizard{
+		joystick: joystick,
+		bindings: make(map[string]string),
+	}
+
+	JoystickUpdate()
+
+	w.axisRest = make([]int16, joystick.NumAxes())
+	for i := range w.axisRest {
+		w.axisRest[i] = joystick.GetAxis(i)
+	}
+
+	return w
+}
+
+// Returns the name of the element the user should press next, or "" once
+// every element has been either recorded or skipped.
+func (w *MappingWizard) Prompt() string {
+	if w.Done() {
+		return ""
+	}
+	return MappingElements[w.current]
+}
+
+// Returns true once the wizard has gone through all standard elements.
+func (w *MappingWizard) Done() bool {
+	return w.current >= len(MappingElements)
+}
+
+// Leaves the current element unmapped and moves on to the next one.
+func (w *MappingWizard) Skip() {
+	if !w.Done() {
+		w.current++
+	}
+}
+
+// Starts over, discarding every recorded binding.
+func (w *MappingWizard) Reset() {
+	w.current = 0
+	w.bindings = make(map[string]string)
+	w.waitRelease = false
+}
+
+// Samples the joystick and, if an input is active, binds it to the current
+// element. Returns true if a binding was recorded by this call.
+func (w *MappingWizard) Poll() bool {
+	if w.Done() {
+		return false
+	}
+
+	JoystickUpdate()
+
+	input := w.activeInput()
+
+	if w.waitRelease {
+		if input == "" {
+			w.waitRelease = false
+		}
+		return false
+	}
+
+	if input == "" {
+		return false
+	}
+
+	w.bindings[MappingElements[w.current]] = input
+	w.current++
+	w.waitRelease = true
+
+	return true
+}
+
+// Returns the raw input currently held on the joystick in mapping notation
+// (eg. "b3", "h0.4" or "a2"), or "" if everything is at rest.
+func (w *MappingWizard) activeInput() string {
+	j := w.joystick
+
+	for i := 0; i < j.NumButtons(); i++ {
+		if j.GetButton(i) != 0 {
+			return fmt.Sprintf("b%d", i)
+		}
+	}
+
+	for i := 0; i < j.NumHats(); i++ {
+		if hat := j.GetHat(i); hat != HAT_CENTERED {
+			return fmt.Sprintf("h%d.%d", i, hat)
+		}
+	}
+
+	for i, rest := range w.axisRest {
+		delta := int(j.GetAxis(i)) - int(rest)
+		if delta > mappingAxisThreshold || delta < -mappingAxisThreshold {
+			return fmt.Sprintf("a%d", i)
+		}
+	}
+
+	return ""
+}
+
+// Returns the mapping string built so far, in the format accepted by
+// GameControllerAddMapping.
+func (w *MappingWizard) Mapping() string {
+	fields := []string{
+		w.joystick.GUIDString(),
+		strings.Replace(w.joystick.Name(), ",", " ", -1),
+	}
+
+	for _, element := range MappingElements {
+		if input, ok := w.bindings[element]; ok {
+			fields = append(fields, element+":"+input)
+		}
+	}
+
+	return strings.Join(fields, ",") + ","
+}
+
+// Registers the built mapping with SDL, after which the joystick can be
+// opened as a game controller. Returns the result of GameControllerAddMapping.
+func (w *MappingWizard) Register() int {
+	return GameControllerAddMapping(w.Mapping())
+}
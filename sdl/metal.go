@@ -0,0 +1,65 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+// #include <SDL2/SDL_metal.h>
+import "C"
+
+import "unsafe"
+
+// A Metal view (an NSView or UIView backed by a CAMetalLayer) attached to
+// a window, for drawing into the window with Metal.
+//
+// AppKit and UIKit views may only be used from the main thread, so the
+// Metal view functions, and Window.Metal_GetDrawableSize, must be called
+// from the main thread on macOS and iOS: lock the main goroutine to it with
+// runtime.LockOSThread in an init function of the main package, and make
+// the calls from main.
+type MetalView struct {
+	cView C.SDL_MetalView
+}
+
+// Creates a Metal view covering the window, which should have been created
+// with WINDOW_METAL. Only available on macOS and iOS; returns nil
+// elsewhere or on error. Must be called from the main thread (see
+// MetalView).
+func Metal_CreateView(w *Window) *MetalView {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	view := C.SDL_Metal_CreateView(w.cWindow)
+	if view == nil {
+		return nil
+	}
+
+	return &MetalView{view}
+}
+
+// Removes a Metal view from its window and destroys it.
+func Metal_DestroyView(view *MetalView) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_Metal_DestroyView(view.cView)
+	view.cView = nil
+}
+
+// Gets the CAMetalLayer of the view, to create drawables from.
+func Metal_GetLayer(view *MetalView) unsafe.Pointer {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_Metal_GetLayer(view.cView)
+}
+
+// Gets the size of the window's Metal drawable in pixels, which is larger
+// than the window size in screen coordinates on high-DPI displays. Must be
+// called from the main thread on macOS and iOS (see MetalView).
+func (w *Window) Metal_GetDrawableSize() (int, int) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var width, height C.int
+	C.SDL_Metal_GetDrawableSize(w.cWindow, &width, &height)
+
+	return int(width), int(height)
+}
@@ -0,0 +1,25 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "unsafe"
+
+// Gets the CAMetalLayer the renderer draws into, for hooking Metal code
+// into the renderer, or nil if the renderer doesn't use Metal.
+func (r *Renderer) GetMetalLayer() unsafe.Pointer {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return C.SDL_RenderGetMetalLayer(r.cRenderer)
+}
+
+// Gets the MTLRenderCommandEncoder used by the renderer for the current
+// frame, or nil if the renderer doesn't use Metal. Anything encoded with
+// it is drawn together with the renderer's own commands at Present.
+func (r *Renderer) GetMetalCommandEncoder() unsafe.Pointer {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return C.SDL_RenderGetMetalCommandEncoder(r.cRenderer)
+}
@@ -0,0 +1,27 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+// #include <SDL2/SDL_system.h>
+import "C"
+
+import "unsafe"
+
+// Gets the IDirect3DDevice9 of a "direct3d" renderer, or nil for other
+// renderers. A reference is added to the device, the caller must Release
+// it when done.
+func (r *Renderer) GetD3D9Device() unsafe.Pointer {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return unsafe.Pointer(C.SDL_RenderGetD3D9Device(r.cRenderer))
+}
+
+// Gets the ID3D11Device of a "direct3d11" renderer, or nil for other
+// renderers. A reference is added to the device, the caller must Release
+// it when done.
+func (r *Renderer) GetD3D11Device() unsafe.Pointer {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return unsafe.Pointer(C.SDL_RenderGetD3D11Device(r.cRenderer))
+}
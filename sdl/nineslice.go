@@ -0,0 +1,110 @@
+package sdl
+
+// Draws a texture as a scalable panel: the corners keep their size, the
+// edges stretch (or repeat) along one axis and the center along both.
+// Left, Top, Right and Bottom are the sizes of the borders in the texture,
+// in pixels.
+type NineSlice struct {
+	Texture *Texture
+	Src     *Rect // Area of the texture to use, the whole texture if nil
+	Left    int
+	Top     int
+	Right   int
+	Bottom  int
+	Tile    bool // Repeat the edges and center instead of stretching them
+
+	rects []Rect // Source and destination rects of the pieces, in pairs
+	cmds  []CopyCmd
+}
+
+// Splits an extent into a border, middle and border, shrinking the
+// borders proportionally if they don't fit.
+func nineSliceSplit(start, size, lo, hi int) [4]int {
+	if lo+hi > size {
+		if lo+hi == 0 {
+			lo, hi = 0, 0
+		} else {
+			lo = lo * size / (lo + hi)
+			hi = size - lo
+		}
+	}
+
+	return [4]int{start, start + lo, start + size - hi, start + size}
+}
+
+// Draws the panel stretched over dst.
+// Returns 0 on success or a negative error code.
+func (n *NineSlice) Draw(r *Renderer, dst Rect) int {
+	var src Rect
+	if n.Src != nil {
+		src = *n.Src
+	} else {
+		w, h := n.Texture.Size()
+		src = Rect{W: int32(w), H: int32(h)}
+	}
+
+	sx := nineSliceSplit(int(src.X), int(src.W), n.Left, n.Right)
+	sy := nineSliceSplit(int(src.Y), int(src.H), n.Top, n.Bottom)
+	dx := nineSliceSplit(int(dst.X), int(dst.W), n.Left, n.Right)
+	dy := nineSliceSplit(int(dst.Y), int(dst.H), n.Top, n.Bottom)
+
+	n.rects = n.rects[:0]
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			s := Rect{int32(sx[col]), int32(sy[row]), int32(sx[col+1] - sx[col]), int32(sy[row+1] - sy[row])}
+			d := Rect{int32(dx[col]), int32(dy[row]), int32(dx[col+1] - dx[col]), int32(dy[row+1] - dy[row])}
+			if s.W <= 0 || s.H <= 0 || d.W <= 0 || d.H <= 0 {
+				continue
+			}
+
+			// Corners are never tiled
+			if !n.Tile || (col != 1 && row != 1) {
+				n.rects = append(n.rects, s, d)
+				continue
+			}
+
+			n.tile(s, d, col == 1, row == 1)
+		}
+	}
+
+	// Point the commands into rects only now that it has stopped growing
+	n.cmds = n.cmds[:0]
+	for i := 0; i < len(n.rects); i += 2 {
+		n.cmds = append(n.cmds, CopyCmd{Texture: n.Texture, Src: &n.rects[i], Dst: &n.rects[i+1]})
+	}
+
+	return r.CopyBatch(n.cmds)
+}
+
+// Covers d with copies of s, repeating it along the given axes and
+// stretching it along the others. The last copy in each direction is
+// cut short.
+func (n *NineSlice) tile(s, d Rect, tileX, tileY bool) {
+	stepX, stepY := d.W, d.H
+	if tileX {
+		stepX = s.W
+	}
+	if tileY {
+		stepY = s.H
+	}
+
+	for y := int32(0); y < d.H; y += stepY {
+		for x := int32(0); x < d.W; x += stepX {
+			piece := Rect{d.X + x, d.Y + y, stepX, stepY}
+			part := s
+			if x+stepX > d.W {
+				piece.W = d.W - x
+				if tileX {
+					part.W = piece.W
+				}
+			}
+			if y+stepY > d.H {
+				piece.H = d.H - y
+				if tileY {
+					part.H = piece.H
+				}
+			}
+			n.rects = append(n.rects, part, piece)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package sdl
+
+import "errors"
+
+// Rotations supported by OrientedRenderer, clockwise in degrees.
+const (
+	ORIENTATION_0   = 0
+	ORIENTATION_90  = 90
+	ORIENTATION_180 = 180
+	ORIENTATION_270 = 270
+)
+
+// Presents everything rendered rotated by a multiple of 90 degrees, for
+// displays mounted in a different orientation than the panel reports,
+// such as portrait signage on a landscape-only panel.
+//
+// Frames are drawn between Begin and Present into an off-screen texture
+// the size of the rotated output, which Present copies to the window
+// rotated. Mouse and touch events, which SDL reports in window
+// coordinates, are mapped to the coordinates of the rotated frame by
+// MapEvent.
+type OrientedRenderer struct {
+	Renderer *Renderer
+
+	orientation int
+	window      *Window
+	target      *Texture
+	outW, outH  int
+}
+
+// Creates an oriented renderer for a renderer drawing into the given
+// window. The renderer must support render targets.
+func NewOrientedRenderer(r *Renderer, w *Window, orientation int) (*OrientedRenderer, error) {
+	if !r.RenderTargetSupported() {
+		return nil, errors.New("sdl: renderer does not support render targets")
+	}
+
+	o := &OrientedRenderer{Renderer: r, window: w}
+	if err := o.SetOrientation(orientation); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// Changes the rotation, one of the ORIENTATION_* constants.
+func (o *OrientedRenderer) SetOrientation(orientation int) error {
+	switch orientation {
+	case ORIENTATION_0, ORIENTATION_90, ORIENTATION_180, ORIENTATION_270:
+	default:
+		return errors.New("sdl: orientation must be a multiple of 90 degrees")
+	}
+
+	o.orientation = orientation
+
+	// Forces Begin to recreate the target with the new size
+	o.outW, o.outH = 0, 0
+	return nil
+}
+
+// Returns the current rotation.
+func (o *OrientedRenderer) Orientation() int {
+	return o.orientation
+}
+
+// Returns the size of the rotated frame, which is the size of the output
+// with width and height swapped for quarter turns.
+func (o *OrientedRenderer) Size() (int, int) {
+	w, h := o.Renderer.outputSize()
+	if o.quarterTurn() {
+		return h, w
+	}
+	return w, h
+}
+
+func (o *OrientedRenderer) quarterTurn() bool {
+	return o.orientation == ORIENTATION_90 || o.orientation == ORIENTATION_270
+}
+
+// Redirects rendering into the rotated frame. Must be called before
+// drawing each frame.
+func (o *OrientedRenderer) Begin() error {
+	defer lockThread()()
+
+	if o.orientation == ORIENTATION_0 {
+		return nil
+	}
+
+	w, h := o.Renderer.outputSize()
+	if o.target == nil || w != o.outW || h != o.outH {
+		if o.target != nil {
+			o.target.Destroy()
+		}
+
+		lw, lh := o.Size()
+		o.target = CreateTexture(o.Renderer, PIXELFORMAT_ARGB8888, TEXTUREACCESS_TARGET, lw, lh)
+		if o.target == nil {
+			return errors.New(GetError())
+		}
+		o.outW, o.outH = w, h
+	}
+
+	if o.Renderer.SetRenderTarget(o.target) != 0 {
+		return errors.New(GetError())
+	}
+
+	return nil
+}
+
+// Copies the rotated frame to the window and presents it.
+func (o *OrientedRenderer) Present() {
+	if o.orientation == ORIENTATION_0 || o.target == nil {
+		o.Renderer.Present()
+		return
+	}
+
+	o.Renderer.SetRenderTarget(nil)
+	o.Renderer.Clear()
+
+	// Rotating the frame around the center of the output makes it cover
+	// the output exactly
+	lw, lh := o.Size()
+	dst := Rect{int32((o.outW - lw) / 2), int32((o.outH - lh) / 2), int32(lw), int32(lh)}
+	o.Renderer.CopyEx(o.target, nil, &dst, float64(o.orientation), nil, FLIP_NONE)
+
+	o.Renderer.Present()
+}
+
+// Maps a point in window coordinates to the rotated frame.
+func (o *OrientedRenderer) MapPoint(x, y int) (int, int) {
+	if o.orientation == ORIENTATION_0 {
+		return x, y
+	}
+
+	// Window coordinates differ from output pixels on high-DPI displays
+	ww, wh := o.window.GetSize()
+	ow, oh := o.Renderer.outputSize()
+	if ww > 0 && wh > 0 {
+		x, y = x*ow/ww, y*oh/wh
+	}
+
+	lw, lh := o.Size()
+	qx, qy := 2*x-ow, 2*y-oh // Doubled to stay in integers
+
+	switch o.orientation {
+	case ORIENTATION_90:
+		return (qy + lw) / 2, (-qx + lh) / 2
+	case ORIENTATION_180:
+		return (-qx + lw) / 2, (-qy + lh) / 2
+	default:
+		return (-qy + lw) / 2, (qx + lh) / 2
+	}
+}
+
+// Rotates a relative movement into the rotated frame.
+func (o *OrientedRenderer) mapDelta(dx, dy int32) (int32, int32) {
+	switch o.orientation {
+	case ORIENTATION_90:
+		return dy, -dx
+	case ORIENTATION_180:
+		return -dx, -dy
+	case ORIENTATION_270:
+		return -dy, dx
+	}
+	return dx, dy
+}
+
+// Maps normalized touch coordinates into the rotated frame.
+func (o *OrientedRenderer) mapNormalized(x, y float32) (float32, float32) {
+	switch o.orientation {
+	case ORIENTATION_90:
+		return y, 1 - x
+	case ORIENTATION_180:
+		return 1 - x, 1 - y
+	case ORIENTATION_270:
+		return 1 - y, x
+	}
+	return x, y
+}
+
+// Rotates a fractional movement into the rotated frame.
+func (o *OrientedRenderer) mapNormalizedDelta(dx, dy float32) (float32, float32) {
+	switch o.orientation {
+	case ORIENTATION_90:
+		return dy, -dx
+	case ORIENTATION_180:
+		return -dx, -dy
+	case ORIENTATION_270:
+		return -dy, dx
+	}
+	return dx, dy
+}
+
+// Returns the event with its coordinates mapped to the rotated frame, for
+// MouseButtonEvents, MouseMotionEvents, MouseWheelEvents and
+// TouchFingerEvents. Other events are returned unchanged.
+func (o *OrientedRenderer) MapEvent(event interface{}) interface{} {
+	if o.orientation == ORIENTATION_0 {
+		return event
+	}
+
+	switch e := event.(type) {
+	case MouseButtonEvent:
+		x, y := o.MapPoint(int(e.X), int(e.Y))
+		e.X, e.Y = int32(x), int32(y)
+		return e
+
+	case MouseMotionEvent:
+		x, y := o.MapPoint(int(e.X), int(e.Y))
+		e.X, e.Y = int32(x), int32(y)
+		e.Xrel, e.Yrel = o.mapDelta(e.Xrel, e.Yrel)
+		return e
+
+	case MouseWheelEvent:
+		// Wheel Y grows away from the user, unlike screen coordinates
+		x, y := o.mapDelta(e.X, -e.Y)
+		e.X, e.Y = x, -y
+		px, py := o.mapNormalizedDelta(e.PreciseX, -e.PreciseY)
+		e.PreciseX, e.PreciseY = px, -py
+		return e
+
+	case TouchFingerEvent:
+		e.X, e.Y = o.mapNormalized(e.X, e.Y)
+		e.Dx, e.Dy = o.mapNormalizedDelta(e.Dx, e.Dy)
+		return e
+	}
+
+	return event
+}
+
+// Destroys the off-screen frame. The wrapped renderer is left alone.
+func (o *OrientedRenderer) Destroy() {
+	if o.target != nil {
+		o.target.Destroy()
+		o.target = nil
+	}
+}
@@ -0,0 +1,63 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "unsafe"
+
+// Creates a palette of ncolors colors, all initially white, or returns nil
+// on error. Release it with FreePalette.
+func AllocPalette(ncolors int) *Palette {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return (*Palette)(cast(C.SDL_AllocPalette(C.int(ncolors))))
+}
+
+// Releases a palette created with AllocPalette. Surfaces using the palette
+// keep a reference to it, so it stays valid for them.
+func FreePalette(p *Palette) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_FreePalette((*C.SDL_Palette)(cast(p)))
+}
+
+// Replaces the palette entries starting at index first with colors.
+// Returns 0 on success or a negative error code.
+func (p *Palette) SetColors(colors []Color, first int) int {
+	if len(colors) == 0 {
+		return 0
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_SetPaletteColors((*C.SDL_Palette)(cast(p)),
+		(*C.SDL_Color)(cast(&colors[0])), C.int(first), C.int(len(colors))))
+}
+
+// Gets a copy of the palette's colors.
+func (p *Palette) GetColors() []Color {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	if p.Ncolors <= 0 {
+		return nil
+	}
+
+	colors := make([]Color, p.Ncolors)
+	copy(colors, unsafe.Slice(p.Colors, int(p.Ncolors)))
+
+	return colors
+}
+
+// Makes an 8-bit (or smaller) surface use the palette.
+// Returns 0 on success or a negative error code.
+func (s *Surface) SetPalette(p *Palette) int {
+	s.check()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return int(C.SDL_SetSurfacePalette(s.cSurface, (*C.SDL_Palette)(cast(p))))
+}
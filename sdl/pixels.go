@@ -0,0 +1,371 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"errors"
+	"strings"
+	"unsafe"
+)
+
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// Reads the pixel value stored in the first bpp bytes of p, in the
+// machine's byte order as SDL stores it.
+func readPixel(p []byte, bpp int) uint32 {
+	switch bpp {
+	case 1:
+		return uint32(p[0])
+	case 2:
+		if littleEndian {
+			return uint32(p[0]) | uint32(p[1])<<8
+		}
+		return uint32(p[0])<<8 | uint32(p[1])
+	case 3:
+		if littleEndian {
+			return uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16
+		}
+		return uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])
+	default:
+		if littleEndian {
+			return uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16 | uint32(p[3])<<24
+		}
+		return uint32(p[0])<<24 | uint32(p[1])<<16 | uint32(p[2])<<8 | uint32(p[3])
+	}
+}
+
+// Returns the surface's pixel memory as a byte slice. The surface must be
+// locked, if it requires locking.
+//
+// The slice is built from the C surface rather than s.Pixels: locking an
+// RLE surface decodes it into newly allocated memory.
+func (s *Surface) pixelBytes() []byte {
+	pixels := s.cSurface.pixels
+	if pixels == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(pixels), int(s.cSurface.h)*int(s.cSurface.pitch))
+}
+
+// Gets the name of a PIXELFORMAT_* value, e.g. "SDL_PIXELFORMAT_ARGB8888",
+// or "SDL_PIXELFORMAT_UNKNOWN" if the value isn't recognized.
+func GetPixelFormatName(format uint32) string {
+	// Returns a static string, no locking needed
+	return C.GoString(C.SDL_GetPixelFormatName(C.Uint32(format)))
+}
+
+// Returns the name of a PIXELFORMAT_* value without the SDL_PIXELFORMAT_
+// prefix, e.g. "ARGB8888".
+func pixelFormatName(format uint32) string {
+	return strings.TrimPrefix(GetPixelFormatName(format), "SDL_PIXELFORMAT_")
+}
+
+// Gets the bits per pixel and channel masks of a PIXELFORMAT_* value, as
+// taken by CreateRGBSurface. ok is false for formats that cannot be
+// described by masks, such as YUV formats.
+func PixelFormatEnumToMasks(format uint32) (bpp int, rmask, gmask, bmask, amask uint32, ok bool) {
+	var cbpp C.int
+	var r, g, b, a C.Uint32
+	if C.SDL_PixelFormatEnumToMasks(C.Uint32(format), &cbpp, &r, &g, &b, &a) != C.SDL_TRUE {
+		return 0, 0, 0, 0, 0, false
+	}
+
+	return int(cbpp), uint32(r), uint32(g), uint32(b), uint32(a), true
+}
+
+// Gets the PIXELFORMAT_* value matching the bits per pixel and channel
+// masks, or PIXELFORMAT_UNKNOWN if there is none.
+func MasksToPixelFormatEnum(bpp int, rmask, gmask, bmask, amask uint32) uint32 {
+	return uint32(C.SDL_MasksToPixelFormatEnum(C.int(bpp),
+		C.Uint32(rmask), C.Uint32(gmask), C.Uint32(bmask), C.Uint32(amask)))
+}
+
+// Gets a description of a PIXELFORMAT_* value, with its masks, shifts and
+// sizes, or nil on error. Release it with FreeFormat.
+func AllocFormat(format uint32) *PixelFormat {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return (*PixelFormat)(cast(C.SDL_AllocFormat(C.Uint32(format))))
+}
+
+// Releases a description returned by AllocFormat.
+func FreeFormat(f *PixelFormat) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_FreeFormat((*C.SDL_PixelFormat)(cast(f)))
+}
+
+// Returns the number of bytes a w x h image in the given format takes up,
+// with rows pitch bytes apart.
+func pixelBufferSize(format uint32, w, h, pitch int) int {
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+
+	switch format {
+	case PIXELFORMAT_YV12, PIXELFORMAT_IYUV, PIXELFORMAT_NV12, PIXELFORMAT_NV21:
+		// A full size Y plane followed by chroma planes of a quarter of
+		// its size in total
+		return pitch*h + 2*((pitch+1)/2)*((h+1)/2)
+	}
+
+	return (h-1)*pitch + w*bytesPerPixel(format)
+}
+
+// Converts a w x h block of pixels from one PIXELFORMAT_* format to
+// another, without creating surfaces. srcPitch and dstPitch are the
+// numbers of bytes between rows in src and dst.
+func ConvertPixels(w, h int, srcFormat uint32, src []byte, srcPitch int, dstFormat uint32, dst []byte, dstPitch int) error {
+	defer lockThread()()
+
+	if len(src) < pixelBufferSize(srcFormat, w, h, srcPitch) {
+		return errors.New("sdl: source buffer too small")
+	}
+	if len(dst) < pixelBufferSize(dstFormat, w, h, dstPitch) {
+		return errors.New("sdl: destination buffer too small")
+	}
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+
+	// Doesn't touch any global state, no locking needed
+	if C.SDL_ConvertPixels(C.int(w), C.int(h),
+		C.Uint32(srcFormat), unsafe.Pointer(&src[0]), C.int(srcPitch),
+		C.Uint32(dstFormat), unsafe.Pointer(&dst[0]), C.int(dstPitch)) != 0 {
+		return sdlError()
+	}
+
+	return nil
+}
+
+// Converts a w x h block of pixels with straight alpha to premultiplied
+// alpha, for use with a premultiplied blend mode (see
+// ComposeCustomBlendMode), which avoids dark fringes around scaled
+// sprites. SDL only premultiplies ARGB8888 pixels; other formats are
+// converted to ARGB8888 and back through a temporary buffer (see
+// ConvertPixels). src and dst may be the same buffer. Requires SDL 2.0.18.
+func PremultiplyAlpha(w, h int, srcFormat uint32, src []byte, srcPitch int, dstFormat uint32, dst []byte, dstPitch int) error {
+	defer lockThread()()
+
+	if len(src) < pixelBufferSize(srcFormat, w, h, srcPitch) {
+		return errors.New("sdl: source buffer too small")
+	}
+	if len(dst) < pixelBufferSize(dstFormat, w, h, dstPitch) {
+		return errors.New("sdl: destination buffer too small")
+	}
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+
+	if srcFormat == PIXELFORMAT_ARGB8888 && dstFormat == PIXELFORMAT_ARGB8888 {
+		return premultiplyARGB8888(w, h, src, srcPitch, dst, dstPitch)
+	}
+
+	pitch := w * 4
+	tmp := make([]byte, pitch*h)
+	if err := ConvertPixels(w, h, srcFormat, src, srcPitch, PIXELFORMAT_ARGB8888, tmp, pitch); err != nil {
+		return err
+	}
+	if err := premultiplyARGB8888(w, h, tmp, pitch, tmp, pitch); err != nil {
+		return err
+	}
+
+	return ConvertPixels(w, h, PIXELFORMAT_ARGB8888, tmp, pitch, dstFormat, dst, dstPitch)
+}
+
+// Premultiplies ARGB8888 pixels, the only format SDL_PremultiplyAlpha
+// accepts. The buffers have been checked by the caller.
+func premultiplyARGB8888(w, h int, src []byte, srcPitch int, dst []byte, dstPitch int) error {
+	defer lockThread()()
+
+	// Doesn't touch any global state, no locking needed
+	if C.SDL_PremultiplyAlpha(C.int(w), C.int(h),
+		C.Uint32(PIXELFORMAT_ARGB8888), unsafe.Pointer(&src[0]), C.int(srcPitch),
+		C.Uint32(PIXELFORMAT_ARGB8888), unsafe.Pointer(&dst[0]), C.int(dstPitch)) != 0 {
+		return sdlError()
+	}
+
+	return nil
+}
+
+// Stores a pixel value in the first bpp bytes of p, in the machine's byte
+// order as SDL stores it.
+func writePixel(p []byte, bpp int, v uint32) {
+	switch bpp {
+	case 1:
+		p[0] = byte(v)
+	case 2:
+		if littleEndian {
+			p[0], p[1] = byte(v), byte(v>>8)
+		} else {
+			p[0], p[1] = byte(v>>8), byte(v)
+		}
+	case 3:
+		if littleEndian {
+			p[0], p[1], p[2] = byte(v), byte(v>>8), byte(v>>16)
+		} else {
+			p[0], p[1], p[2] = byte(v>>16), byte(v>>8), byte(v)
+		}
+	default:
+		if littleEndian {
+			p[0], p[1], p[2], p[3] = byte(v), byte(v>>8), byte(v>>16), byte(v>>24)
+		} else {
+			p[0], p[1], p[2], p[3] = byte(v>>24), byte(v>>16), byte(v>>8), byte(v)
+		}
+	}
+}
+
+// Returns the colors of a palette, without copying them.
+func paletteColors(p *Palette) []Color {
+	if p == nil || p.Ncolors <= 0 {
+		return nil
+	}
+	return unsafe.Slice(p.Colors, int(p.Ncolors))
+}
+
+// Extracts one channel of a pixel value and scales it to 8 bits, so that
+// full intensity is 0xFF whatever the channel's size.
+func unpackChannel(v, mask uint32, shift, loss uint8) uint8 {
+	if loss >= 8 {
+		return 0
+	}
+
+	max := uint32(0xFF) >> loss
+	c := (v & mask) >> shift
+	return uint8((c*0xFF + max/2) / max)
+}
+
+// Splits a pixel value in the given format into its components, like
+// SDL_GetRGBA. Formats without alpha are opaque.
+func unpackPixel(f *PixelFormat, v uint32) (r, g, b, a uint8) {
+	if f.BitsPerPixel <= 8 && f.Palette != nil {
+		colors := paletteColors(f.Palette)
+		if int(v) >= len(colors) {
+			return 0, 0, 0, 0xFF
+		}
+		c := colors[v]
+		return c.R, c.G, c.B, c.Alpha
+	}
+
+	r = unpackChannel(v, f.Rmask, f.Rshift, f.Rloss)
+	g = unpackChannel(v, f.Gmask, f.Gshift, f.Gloss)
+	b = unpackChannel(v, f.Bmask, f.Bshift, f.Bloss)
+	a = 0xFF
+	if f.Amask != 0 {
+		a = unpackChannel(v, f.Amask, f.Ashift, f.Aloss)
+	}
+
+	return r, g, b, a
+}
+
+// Builds the pixel value of a color in the given format, like
+// SDL_MapRGBA. For paletted formats this is the closest palette entry.
+func packPixel(f *PixelFormat, r, g, b, a uint8) uint32 {
+	if f.BitsPerPixel <= 8 && f.Palette != nil {
+		best, bestDist := 0, -1
+		for i, c := range paletteColors(f.Palette) {
+			dr, dg, db, da := int(c.R)-int(r), int(c.G)-int(g), int(c.B)-int(b), int(c.Alpha)-int(a)
+			dist := dr*dr + dg*dg + db*db + da*da
+			if bestDist < 0 || dist < bestDist {
+				best, bestDist = i, dist
+			}
+		}
+		return uint32(best)
+	}
+
+	v := uint32(r>>f.Rloss)<<f.Rshift | uint32(g>>f.Gloss)<<f.Gshift | uint32(b>>f.Bloss)<<f.Bshift
+	if f.Amask != 0 {
+		v |= uint32(a>>f.Aloss) << f.Ashift
+	}
+
+	return v
+}
+
+// Returns the surface's pixel memory as a slice of H rows, Pitch bytes
+// apart. Surfaces that require locking (RLE surfaces) must be locked
+// while the slice is used.
+func (s *Surface) PixelsSlice() []byte {
+	s.check()
+	return s.pixelBytes()
+}
+
+// Panics if the surface's format packs several pixels into a byte, which
+// pixel accessors don't support.
+func (s *Surface) checkBytePixels() {
+	if s.Format.BitsPerPixel < 8 {
+		panic("sdl: pixel formats of less than 8 bits per pixel are not supported")
+	}
+}
+
+// Calls f with the surface's pixel memory, holding the surface's mutex
+// (exclusively if write is set) and locking the surface around the call
+// if it requires locking. f is not called if locking fails.
+func (s *Surface) withPixels(write bool, f func(pixels []byte)) {
+	// SDL encodes the surface lazily, so s.Flags may be out of date
+	if !write {
+		s.mutex.RLock()
+		if s.cSurface.flags&C.SDL_RLEACCEL == 0 {
+			defer s.mutex.RUnlock()
+			f(s.pixelBytes())
+			return
+		}
+		// Locking an RLE surface modifies it, even to read it
+		s.mutex.RUnlock()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cSurface.flags&C.SDL_RLEACCEL != 0 {
+		if s.lock() < 0 {
+			return
+		}
+		defer s.unlock()
+	}
+
+	// Only taken now: locking an RLE surface decodes it into new memory
+	f(s.pixelBytes())
+}
+
+// Gets the value of the pixel at x,y in the surface's pixel format (see
+// MapRGBA), or 0 outside the surface. Panics for formats packing several
+// pixels into a byte (PIXELFORMAT_INDEX1*, PIXELFORMAT_INDEX4*).
+func (s *Surface) GetPixel(x, y int) uint32 {
+	s.check()
+	s.checkBytePixels()
+
+	var v uint32
+	if x < 0 || y < 0 || x >= int(s.W) || y >= int(s.H) {
+		return v
+	}
+
+	s.withPixels(false, func(pixels []byte) {
+		bpp := int(s.Format.BytesPerPixel)
+		v = readPixel(pixels[y*int(s.Pitch)+x*bpp:], bpp)
+	})
+
+	return v
+}
+
+// Sets the value of the pixel at x,y in the surface's pixel format.
+// Points outside the surface are ignored. Panics for formats packing
+// several pixels into a byte (PIXELFORMAT_INDEX1*, PIXELFORMAT_INDEX4*).
+func (s *Surface) SetPixel(x, y int, color uint32) {
+	s.check()
+	s.checkBytePixels()
+
+	if x < 0 || y < 0 || x >= int(s.W) || y >= int(s.H) {
+		return
+	}
+
+	s.withPixels(true, func(pixels []byte) {
+		bpp := int(s.Format.BytesPerPixel)
+		writePixel(pixels[y*int(s.Pitch)+x*bpp:], bpp, color)
+	})
+}
@@ -0,0 +1,109 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+// The rect functions only compute, so they are called without locking.
+
+func (r *Rect) c() *C.SDL_Rect {
+	return (*C.SDL_Rect)(cast(r))
+}
+
+// Checks whether the two rectangles overlap.
+func (a Rect) HasIntersection(b Rect) bool {
+	return C.SDL_HasIntersection(a.c(), b.c()) == C.SDL_TRUE
+}
+
+// Gets the overlap of the two rectangles. ok is false if they don't
+// overlap.
+func (a Rect) Intersect(b Rect) (result Rect, ok bool) {
+	ok = C.SDL_IntersectRect(a.c(), b.c(), result.c()) == C.SDL_TRUE
+	return result, ok
+}
+
+// Gets the smallest rectangle containing both rectangles.
+func (a Rect) Union(b Rect) (result Rect) {
+	C.SDL_UnionRect(a.c(), b.c(), result.c())
+	return result
+}
+
+// Checks whether the point lies inside the rectangle.
+func (r Rect) Contains(p Point) bool {
+	return C.SDL_PointInRect((*C.SDL_Point)(cast(&p)), r.c()) == C.SDL_TRUE
+}
+
+// Clips the line from x1,y1 to x2,y2 to the rectangle. ok is false if the
+// line lies entirely outside it.
+func (r Rect) IntersectLine(x1, y1, x2, y2 int) (cx1, cy1, cx2, cy2 int, ok bool) {
+	ax, ay, bx, by := C.int(x1), C.int(y1), C.int(x2), C.int(y2)
+	if C.SDL_IntersectRectAndLine(r.c(), &ax, &ay, &bx, &by) != C.SDL_TRUE {
+		return 0, 0, 0, 0, false
+	}
+
+	return int(ax), int(ay), int(bx), int(by), true
+}
+
+// Gets the smallest rectangle containing the points, considering only the
+// points inside clip if it isn't nil. ok is false if no point was
+// considered.
+func EnclosePoints(points []Point, clip *Rect) (result Rect, ok bool) {
+	if len(points) == 0 {
+		return result, false
+	}
+
+	ok = C.SDL_EnclosePoints((*C.SDL_Point)(cast(&points[0])), C.int(len(points)),
+		clip.c(), result.c()) == C.SDL_TRUE
+	return result, ok
+}
+
+func (r *FRect) c() *C.SDL_FRect {
+	return (*C.SDL_FRect)(cast(r))
+}
+
+// Checks whether the two rectangles overlap. Requires SDL 2.0.22, as do
+// the other FRect functions.
+func (a FRect) HasIntersection(b FRect) bool {
+	return C.SDL_HasIntersectionF(a.c(), b.c()) == C.SDL_TRUE
+}
+
+// Gets the overlap of the two rectangles. ok is false if they don't
+// overlap.
+func (a FRect) Intersect(b FRect) (result FRect, ok bool) {
+	ok = C.SDL_IntersectFRect(a.c(), b.c(), result.c()) == C.SDL_TRUE
+	return result, ok
+}
+
+// Gets the smallest rectangle containing both rectangles.
+func (a FRect) Union(b FRect) (result FRect) {
+	C.SDL_UnionFRect(a.c(), b.c(), result.c())
+	return result
+}
+
+// Checks whether the point lies inside the rectangle.
+func (r FRect) Contains(p FPoint) bool {
+	return C.SDL_PointInFRect((*C.SDL_FPoint)(cast(&p)), r.c()) == C.SDL_TRUE
+}
+
+// Clips the line from x1,y1 to x2,y2 to the rectangle. ok is false if the
+// line lies entirely outside it.
+func (r FRect) IntersectLine(x1, y1, x2, y2 float32) (cx1, cy1, cx2, cy2 float32, ok bool) {
+	ax, ay, bx, by := C.float(x1), C.float(y1), C.float(x2), C.float(y2)
+	if C.SDL_IntersectFRectAndLine(r.c(), &ax, &ay, &bx, &by) != C.SDL_TRUE {
+		return 0, 0, 0, 0, false
+	}
+
+	return float32(ax), float32(ay), float32(bx), float32(by), true
+}
+
+// Gets the smallest rectangle containing the points, considering only the
+// points inside clip if it isn't nil. ok is false if no point was
+// considered.
+func EncloseFPoints(points []FPoint, clip *FRect) (result FRect, ok bool) {
+	if len(points) == 0 {
+		return result, false
+	}
+
+	ok = C.SDL_EncloseFPoints((*C.SDL_FPoint)(cast(&points[0])), C.int(len(points)),
+		clip.c(), result.c()) == C.SDL_TRUE
+	return result, ok
+}
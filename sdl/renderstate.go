@@ -0,0 +1,90 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "errors"
+
+var errNoRenderState = errors.New("sdl: no saved render state")
+
+// Renderer settings saved by PushState.
+type renderState struct {
+	r, g, b, a  C.Uint8
+	blendMode   C.SDL_BlendMode
+	viewport    C.SDL_Rect
+	clip        C.SDL_Rect
+	clipEnabled bool
+	target      *Texture
+}
+
+// Saves the draw color, draw blend mode, viewport, clip rectangle and
+// render target, so they can be restored by PopState. Drawing routines
+// that change these settings can push the state on entry and pop it on
+// return to avoid leaking their changes to the caller. Pushes and pops
+// must be balanced; states are saved per renderer, shared by every
+// *Renderer referring to it.
+func (r *Renderer) PushState() {
+	r.acquireWindow()
+	defer r.releaseWindow()
+
+	var s renderState
+	C.SDL_GetRenderDrawColor(r.cRenderer, &s.r, &s.g, &s.b, &s.a)
+	C.SDL_GetRenderDrawBlendMode(r.cRenderer, &s.blendMode)
+	C.SDL_RenderGetViewport(r.cRenderer, &s.viewport)
+	C.SDL_RenderGetClipRect(r.cRenderer, &s.clip)
+	s.clipEnabled = C.SDL_RenderIsClipEnabled(r.cRenderer) == C.SDL_TRUE
+
+	// SDL resets the target when the target texture is destroyed
+	if C.SDL_GetRenderTarget(r.cRenderer) != nil {
+		s.target = r.shared.target
+	}
+
+	r.shared.states = append(r.shared.states, s)
+}
+
+// Restores the settings saved by the matching PushState. Returns an error
+// if there is no saved state. If the saved render target has been
+// destroyed since, rendering goes back to the window and the returned
+// error matches ErrDestroyed; the other settings are still restored.
+func (r *Renderer) PopState() error {
+	r.acquireWindow()
+	defer r.releaseWindow()
+
+	n := len(r.shared.states)
+	if n == 0 {
+		return errNoRenderState
+	}
+
+	s := r.shared.states[n-1]
+	r.shared.states = r.shared.states[:n-1]
+
+	var err error
+	var target *C.SDL_Texture
+	if s.target != nil {
+		if s.target.cTexture == nil {
+			s.target = nil
+			err = destroyedError("render target")
+		} else {
+			target = s.target.cTexture
+		}
+	}
+
+	// Changing the target resets the viewport and clip rectangle, so it
+	// goes first
+	if C.SDL_GetRenderTarget(r.cRenderer) != target {
+		C.SDL_SetRenderTarget(r.cRenderer, target)
+	}
+	r.shared.target = s.target
+
+	C.SDL_RenderSetViewport(r.cRenderer, &s.viewport)
+	if s.clipEnabled {
+		C.SDL_RenderSetClipRect(r.cRenderer, &s.clip)
+	} else {
+		C.SDL_RenderSetClipRect(r.cRenderer, nil)
+	}
+
+	C.SDL_SetRenderDrawColor(r.cRenderer, s.r, s.g, s.b, s.a)
+	C.SDL_SetRenderDrawBlendMode(r.cRenderer, s.blendMode)
+
+	return err
+}
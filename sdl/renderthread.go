@@ -0,0 +1,90 @@
+package sdl
+
+import "runtime"
+
+// Confines a Renderer to a single OS thread.
+//
+// Most renderer backends (OpenGL in particular) require every call to come
+// from the thread that created the renderer, which goroutines do not
+// guarantee. A RenderThread starts a goroutine locked to its OS thread,
+// creates the renderer there, and then executes commands sent to it one at
+// a time, in the order they were sent.
+type RenderThread struct {
+	commands chan []func(*Renderer)
+	done     chan bool
+}
+
+// Starts a render thread. The create function is run on the new thread and
+// should create the renderer (and, if the platform requires it, the window).
+// queue is the number of command batches that may be pending before Do and
+// Batch block. Returns nil if create returns nil.
+func NewRenderThread(create func() *Renderer, queue int) *RenderThread {
+	t := &RenderThread{
+		commands: make(chan []func(*Renderer), queue),
+		done:     make(chan bool),
+	}
+
+	started := make(chan bool)
+
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		renderer := create()
+		started <- renderer != nil
+		if renderer == nil {
+			return
+		}
+
+		for batch := range t.commands {
+			for _, command := range batch {
+				command(renderer)
+			}
+		}
+
+		close(t.done)
+	}()
+
+	if !<-started {
+		return nil
+	}
+
+	return t
+}
+
+// Queues a command for execution on the render thread and returns
+// without waiting for it to run.
+func (t *RenderThread) Do(command func(*Renderer)) {
+	t.commands <- []func(*Renderer){command}
+}
+
+// Queues several commands which are executed back to back, without
+// commands sent by other goroutines in between.
+func (t *RenderThread) Batch(commands ...func(*Renderer)) {
+	t.commands <- commands
+}
+
+// Runs a command on the render thread and waits for it to finish.
+// Every command queued before it has finished too by the time Call returns.
+func (t *RenderThread) Call(command func(*Renderer)) {
+	finished := make(chan bool)
+
+	t.commands <- []func(*Renderer){func(r *Renderer) {
+		command(r)
+		close(finished)
+	}}
+
+	<-finished
+}
+
+// Runs the remaining queued commands, then the cleanup function (which
+// typically destroys the renderer), and stops the thread. The RenderThread
+// must not be used afterwards.
+func (t *RenderThread) Stop(cleanup func(*Renderer)) {
+	if cleanup != nil {
+		t.Do(cleanup)
+	}
+
+	close(t.commands)
+	<-t.done
+}
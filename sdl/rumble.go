@@ -0,0 +1,170 @@
+package sdl
+
+import (
+	"sync"
+	"time"
+)
+
+// One step of a rumble pattern. Low and High are the intensities of the
+// low and high frequency motors, from 0 to 1.
+type RumbleStep struct {
+	Low      float32
+	High     float32
+	Duration time.Duration
+}
+
+// A sequence of rumble steps, played once or repeated until stopped.
+type RumblePattern struct {
+	Steps []RumbleStep
+	Loop  bool
+}
+
+var (
+	// Two short pulses followed by a pause.
+	HeartbeatRumble = RumblePattern{
+		Steps: []RumbleStep{
+			{Low: 0.8, Duration: 80 * time.Millisecond},
+			{Duration: 120 * time.Millisecond},
+			{Low: 0.5, Duration: 80 * time.Millisecond},
+			{Duration: 600 * time.Millisecond},
+		},
+		Loop: true,
+	}
+
+	// A steady low rumble with a slight flutter.
+	EngineRumble = RumblePattern{
+		Steps: []RumbleStep{
+			{Low: 0.35, High: 0.1, Duration: 60 * time.Millisecond},
+			{Low: 0.3, High: 0.05, Duration: 60 * time.Millisecond},
+		},
+		Loop: true,
+	}
+)
+
+// Plays rumble patterns on joysticks. Every device has its own schedule,
+// so playing a pattern on one device does not affect the others, while
+// playing a new pattern on a device replaces the one it was playing.
+type RumbleSequencer struct {
+	mutex   sync.Mutex
+	players map[*Joystick]chan bool
+}
+
+func NewRumbleSequencer() *RumbleSequencer {
+	return &RumbleSequencer{players: make(map[*Joystick]chan bool)}
+}
+
+// Starts playing a pattern on the joystick. Returns immediately.
+func (s *RumbleSequencer) Play(j *Joystick, pattern RumblePattern) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stop(j)
+
+	if len(pattern.Steps) == 0 {
+		return
+	}
+
+	stop := make(chan bool)
+	s.players[j] = stop
+
+	// The steps are copied so the caller may modify the pattern afterwards
+	steps := append([]RumbleStep(nil), pattern.Steps...)
+	go s.run(j, steps, pattern.Loop, stop)
+}
+
+// Stops the pattern playing on the joystick, if any.
+func (s *RumbleSequencer) Stop(j *Joystick) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stop(j)
+}
+
+// Stops the patterns playing on every joystick.
+func (s *RumbleSequencer) StopAll() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for j := range s.players {
+		s.stop(j)
+	}
+}
+
+// Checks whether a pattern is playing on the joystick.
+func (s *RumbleSequencer) Playing(j *Joystick) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.players[j]
+	return ok
+}
+
+// Must be called with the mutex held.
+func (s *RumbleSequencer) stop(j *Joystick) {
+	if stop, ok := s.players[j]; ok {
+		close(stop)
+		delete(s.players, j)
+		j.Rumble(0, 0, 0)
+	}
+}
+
+func (s *RumbleSequencer) run(j *Joystick, steps []RumbleStep, loop bool, stop chan bool) {
+	timer := time.NewTimer(0)
+	<-timer.C
+
+	for {
+		for _, step := range steps {
+			if !s.start(j, step, stop) {
+				return
+			}
+
+			timer.Reset(step.Duration)
+			select {
+			case <-stop:
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+
+		if !loop {
+			break
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.players[j] == stop {
+		s.stop(j)
+	}
+}
+
+// Starts the effect of a step, unless the pattern has been stopped or
+// replaced in the meantime. The check and the effect happen under the
+// mutex so a stopped pattern can never restart the motors.
+func (s *RumbleSequencer) start(j *Joystick, step RumbleStep, stop chan bool) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.players[j] != stop {
+		return false
+	}
+
+	// The effect runs a little longer than the step so the motors don't
+	// stop briefly between consecutive steps
+	j.Rumble(motorIntensity(step.Low), motorIntensity(step.High),
+		uint32((step.Duration+50*time.Millisecond)/time.Millisecond))
+
+	return true
+}
+
+func motorIntensity(v float32) uint16 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 1 {
+		return 0xFFFF
+	}
+	return uint16(v * 0xFFFF)
+}
@@ -0,0 +1,174 @@
+package sdl
+
+import (
+	"math"
+	"sync"
+)
+
+// The DPI at which desktop environments draw at a scale of 1.
+const referenceDPI = 96
+
+// Works out how much drawing in each window should be scaled up so it
+// looks the same size on every display, and tells subscribers when that
+// changes.
+//
+// The scale factor of a window is, in order of precedence:
+//
+//   - an override set with SetOverride for the window,
+//   - the global override set with SetOverride(0, scale),
+//   - the ratio of the drawable size to the window size, for windows
+//     created with WINDOW_ALLOW_HIGHDPI on displays where the two differ,
+//   - the DPI of the window's display relative to 96 DPI, rounded to a
+//     multiple of 0.25,
+//   - 1.
+//
+// The service learns that a window moved to another display or changed
+// size from the WindowEvents passed to HandleEvent.
+type ScaleService struct {
+	mutex       sync.Mutex
+	overrides   map[uint32]float64
+	scales      map[uint32]float64
+	subscribers map[int]func(windowId uint32, scale float64)
+	nextId      int
+}
+
+func NewScaleService() *ScaleService {
+	return &ScaleService{
+		overrides:   make(map[uint32]float64),
+		scales:      make(map[uint32]float64),
+		subscribers: make(map[int]func(uint32, float64)),
+	}
+}
+
+// Gets the scale factor of the window with the given ID.
+func (s *ScaleService) ScaleFactor(windowId uint32) float64 {
+	s.mutex.Lock()
+	scale, ok := s.scales[windowId]
+	s.mutex.Unlock()
+
+	if !ok {
+		scale = s.update(windowId)
+	}
+
+	return scale
+}
+
+// Overrides the scale factor of a window, typically with a value from the
+// application's settings. A windowId of 0 overrides the scale factor of
+// every window without an override of its own. A scale of 0 removes the
+// override.
+func (s *ScaleService) SetOverride(windowId uint32, scale float64) {
+	s.mutex.Lock()
+	if scale > 0 {
+		s.overrides[windowId] = scale
+	} else {
+		delete(s.overrides, windowId)
+	}
+
+	ids := make([]uint32, 0, len(s.scales))
+	for id := range s.scales {
+		ids = append(ids, id)
+	}
+	s.mutex.Unlock()
+
+	for _, id := range ids {
+		s.update(id)
+	}
+}
+
+// Registers a function called whenever the scale factor of a window
+// changes. Returns a function which removes the subscription.
+func (s *ScaleService) Subscribe(f func(windowId uint32, scale float64)) (unsubscribe func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextId
+	s.nextId++
+	s.subscribers[id] = f
+
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		delete(s.subscribers, id)
+	}
+}
+
+// Updates the scale factors for window events that may change them.
+// Other events are ignored, so it is safe to pass every event received
+// from sdl.Events.
+func (s *ScaleService) HandleEvent(event interface{}) {
+	e, ok := event.(WindowEvent)
+	if !ok {
+		return
+	}
+
+	switch e.Event {
+	case WINDOWEVENT_MOVED, WINDOWEVENT_SIZE_CHANGED, WINDOWEVENT_SHOWN:
+		s.update(e.WindowId)
+	case WINDOWEVENT_CLOSE:
+		s.mutex.Lock()
+		delete(s.scales, e.WindowId)
+		s.mutex.Unlock()
+	}
+}
+
+// Recomputes the scale factor of a window and notifies the subscribers
+// if it changed.
+func (s *ScaleService) update(windowId uint32) float64 {
+	s.mutex.Lock()
+	override, ok := s.overrides[windowId]
+	if !ok {
+		override = s.overrides[0]
+	}
+	s.mutex.Unlock()
+
+	scale := override
+	if scale == 0 {
+		scale = windowScale(windowId)
+	}
+
+	s.mutex.Lock()
+	old, known := s.scales[windowId]
+	s.scales[windowId] = scale
+
+	var subscribers []func(uint32, float64)
+	if known && old != scale {
+		for _, f := range s.subscribers {
+			subscribers = append(subscribers, f)
+		}
+	}
+	s.mutex.Unlock()
+
+	for _, f := range subscribers {
+		f(windowId, scale)
+	}
+
+	return scale
+}
+
+// Computes the scale factor of a window from SDL's point of view.
+func windowScale(windowId uint32) float64 {
+	w := GetWindowFromID(windowId)
+	if w == nil {
+		return 1
+	}
+
+	width, _ := w.GetSize()
+	pixels, _ := w.drawableSize()
+	if width > 0 && pixels > width {
+		return float64(pixels) / float64(width)
+	}
+
+	var hdpi float32
+	if display := w.GetDisplayIndex(); display < 0 || GetDisplayDPI(display, nil, &hdpi, nil) != 0 || hdpi <= 0 {
+		return 1
+	}
+
+	scale := math.Round(float64(hdpi)/referenceDPI*4) / 4
+	if scale < 1 {
+		return 1
+	}
+
+	return scale
+}
@@ -0,0 +1,104 @@
+package sdl
+
+import "time"
+
+// Runs a screensaver or attract-mode loop: one borderless window covering
+// each display, each redrawn by the Render callback, until the user
+// presses a key or button or moves the mouse.
+type Screensaver struct {
+	// Title given to the created windows.
+	Title string
+
+	// Frames per second, defaults to 30.
+	FPS int
+
+	// Time after start-up during which mouse motion is ignored, since
+	// creating and showing the windows tends to generate motion events.
+	// Defaults to half a second.
+	Grace time.Duration
+
+	// Called once per frame for every display. The renderer is presented
+	// after the callback returns.
+	Render func(display int, r *Renderer, bounds Rect)
+}
+
+type screensaverOutput struct {
+	window   *Window
+	renderer *Renderer
+	bounds   Rect
+}
+
+// Creates the windows and runs the loop until input is received.
+// Returns 0 on normal exit, or -1 if a window or renderer could not be
+// created (see GetError).
+func (s *Screensaver) Run() int {
+	fps := s.FPS
+	if fps <= 0 {
+		fps = 30
+	}
+
+	grace := s.Grace
+	if grace == 0 {
+		grace = time.Second / 2
+	}
+
+	outputs := make([]screensaverOutput, 0, GetNumVideoDisplays())
+	defer func() {
+		for _, o := range outputs {
+			o.renderer.Destroy()
+			o.window.Destroy()
+		}
+	}()
+
+	for i := 0; i < cap(outputs); i++ {
+		var bounds Rect
+		if GetDisplayBounds(i, &bounds) != 0 {
+			return -1
+		}
+
+		window := CreateWindow(s.Title, int(bounds.X), int(bounds.Y),
+			int(bounds.W), int(bounds.H), WINDOW_BORDERLESS|WINDOW_SHOWN)
+		if window == nil {
+			return -1
+		}
+
+		renderer, err := CreateRenderer(window, -1, 0)
+		if err != nil {
+			window.Destroy()
+			return -1
+		}
+
+		outputs = append(outputs, screensaverOutput{window, renderer, bounds})
+	}
+
+	cursor := ShowCursor(QUERY)
+	ShowCursor(DISABLE)
+	defer ShowCursor(cursor)
+
+	ticker := time.NewTicker(time.Second / time.Duration(fps))
+	defer ticker.Stop()
+
+	start := time.Now()
+
+	for {
+		select {
+		case <-ticker.C:
+			for i, o := range outputs {
+				if s.Render != nil {
+					s.Render(i, o.renderer, o.bounds)
+				}
+				o.renderer.Present()
+			}
+
+		case event := <-Events:
+			switch event.(type) {
+			case QuitEvent, KeyboardEvent, MouseButtonEvent, JoyButtonEvent:
+				return 0
+			case MouseMotionEvent:
+				if time.Since(start) > grace {
+					return 0
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"image"
+	"image/png"
+	"os"
+)
+
+// Captures the whole current rendering target, the window or a target
+// texture, whatever the viewport and logical size. Call it after drawing
+// and before Present, the contents of the window are undefined after
+// Present. The alpha channel of the window is ignored, window captures
+// are opaque.
+func (r *Renderer) Screenshot() (image.Image, error) {
+	target := r.GetRenderTarget()
+
+	var w, h int
+	if target != nil {
+		w, h = target.Size()
+	} else {
+		w, h = r.outputSize()
+	}
+
+	// SDL only reads within the viewport, so it is reset to cover the
+	// whole target during the capture
+	r.PushState()
+	r.resetViewport()
+	pix, pitch, err := r.ReadPixels(&Rect{0, 0, int32(w), int32(h)}, PIXELFORMAT_RGBA32)
+	r.PopState()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if target == nil {
+		for i := 3; i < len(pix); i += 4 {
+			pix[i] = 0xFF
+		}
+	}
+
+	return &image.NRGBA{
+		Pix:    pix,
+		Stride: pitch,
+		Rect:   image.Rect(0, 0, pitch/4, len(pix)/pitch),
+	}, nil
+}
+
+// Makes the viewport cover the whole rendering target, in its pixels.
+func (r *Renderer) resetViewport() {
+	r.acquireWindow()
+	defer r.releaseWindow()
+
+	C.SDL_RenderSetViewport(r.cRenderer, nil)
+}
+
+// Captures the current rendering target like Screenshot and saves it to a
+// PNG file.
+func (r *Renderer) SaveScreenshotPNG(path string) error {
+	img, err := r.Screenshot()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
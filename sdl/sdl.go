@@ -7,17 +7,26 @@ flavor (eg. Rather than sdl.Flip(surface) it's surface.Flip() )
 */
 package sdl
 
-// #cgo pkg-config: sdl2 SDL2_image
-//
 // struct private_hwdata{};
 // struct SDL_BlitMap{};
 // #define map _map
 //
 // #include <SDL2/SDL.h>
 // #include <SDL2/SDL_image.h>
+//
+// static const char *getErrorMsg(char *buf, int len) {
+// #if SDL_VERSION_ATLEAST(2, 0, 14)
+//	return SDL_GetErrorMsg(buf, len);
+// #else
+//	SDL_strlcpy(buf, SDL_GetError(), len);
+//	return buf;
+// #endif
+// }
 import "C"
 
 import (
+	"errors"
+	"image/color"
 	"os"
 	"reflect"
 	"runtime"
@@ -36,6 +45,8 @@ type cast unsafe.Pointer
 // Surface-level functions (such as 'Surface.Blit') are not using this mutex,
 // so it is possible to modify multiple surfaces concurrently.
 // There is no dependency between 'Surface.Lock' and the global mutex.
+// Renderers and their textures are serialized by a lock of their own, see
+// the documentation of Renderer.
 var GlobalMutex sync.Mutex
 
 type Surface struct {
@@ -63,12 +74,37 @@ type Window struct {
 	H     int32
 }
 
+// A 2D renderer for a window, texture or surface.
+//
+// Calls on a renderer and its textures are serialized by a lock belonging
+// to the renderer. Calls that also touch the renderer's window or the
+// video backend (Present, GetOutputSize, ReadPixels, SetVSync,
+// SetLogicalSize, SetRenderTarget, PushState, PopState and Destroy) take
+// GlobalMutex as well, so they don't race with Window methods and event
+// polling.
+//
+// SDL does not make renderers safe to use from other threads: a renderer
+// must be used from the thread that created its window (see
+// RenderThread).
 type Renderer struct {
 	cRenderer *C.SDL_Renderer
+	mutex     *sync.Mutex
+	shared    *rendererShared
+}
+
+// State shared by all wrappers of the same SDL_Renderer.
+type rendererShared struct {
+	mutex     sync.Mutex
+	states    []renderState // Saved by PushState
+	target    *Texture      // Set by SetRenderTarget
+	batch     copyBuffer    // Reused by CopyBatch
+	destroyed bool
 }
 
 type Texture struct {
 	cTexture *C.SDL_Texture
+	mutex    *sync.Mutex // Lock of the texture's renderer
+	renderer *rendererShared
 }
 
 func ptr(v interface{}) unsafe.Pointer {
@@ -94,6 +130,13 @@ func ptr(v interface{}) unsafe.Pointer {
 	return unsafe.Pointer(et.UnsafeAddr())
 }
 
+func cbool(b bool) C.SDL_bool {
+	if b {
+		return C.SDL_TRUE
+	}
+	return C.SDL_FALSE
+}
+
 func wrapSurface(cSurface *C.SDL_Surface) *Surface {
 	var s *Surface
 
@@ -113,7 +156,7 @@ func wrapWindow(cWindow *C.SDL_Window) *Window {
 
 	if cWindow != nil {
 		var window Window
-		w.cWindow = (*C.SDL_Window)(cWindow)
+		window.cWindow = (*C.SDL_Window)(cWindow)
 		w = &window
 	} else {
 		w = nil
@@ -122,12 +165,27 @@ func wrapWindow(cWindow *C.SDL_Window) *Window {
 	return w
 }
 
+// The shared state of the existing renderers.
+var renderers = make(map[*C.SDL_Renderer]*rendererShared)
+var renderersLock sync.Mutex
+
 func wrapRenderer(cRenderer *C.SDL_Renderer) *Renderer {
 	var r *Renderer
 
 	if cRenderer != nil {
 		var renderer Renderer
 		renderer.cRenderer = (*C.SDL_Renderer)(unsafe.Pointer(cRenderer))
+
+		renderersLock.Lock()
+		renderer.shared = renderers[cRenderer]
+		if renderer.shared == nil {
+			renderer.shared = new(rendererShared)
+			renderers[cRenderer] = renderer.shared
+		}
+		renderersLock.Unlock()
+
+		renderer.mutex = &renderer.shared.mutex
+
 		r = &renderer
 	} else {
 		r = nil
@@ -136,12 +194,14 @@ func wrapRenderer(cRenderer *C.SDL_Renderer) *Renderer {
 	return r
 }
 
-func wrapTexture(cTexture *C.SDL_Texture) *Texture {
+func wrapTexture(cTexture *C.SDL_Texture, renderer *rendererShared) *Texture {
 	var t *Texture
 
 	if cTexture != nil {
 		var texture Texture
 		texture.cTexture = (*C.SDL_Texture)(unsafe.Pointer(cTexture))
+		texture.mutex = &renderer.mutex
+		texture.renderer = renderer
 		t = &texture
 	} else {
 		t = nil
@@ -178,311 +238,1806 @@ func (s *Surface) destroy() {
 // Renderer
 // =======
 
-func CreateRenderer(w *Window, index int, flags uint32) *Renderer {
+// Creates a renderer for a window. index is the rendering driver to use
+// (see GetRenderDriverInfo), or -1 for the first one supporting the
+// requested RENDERER_* flags.
+func CreateRenderer(w *Window, index int, flags uint32) (*Renderer, error) {
+	defer lockThread()()
+
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
 	renderer := C.SDL_CreateRenderer(w.cWindow, C.int(index), C.Uint32(flags))
+	if renderer == nil {
+		return nil, sdlError()
+	}
 
-	return wrapRenderer(renderer)
+	return wrapRenderer(renderer), nil
 }
 
-func (r *Renderer) Clear() {
+// Creates a software renderer drawing into a surface, which needs no
+// window or display: useful for headless rendering, generating images on
+// servers and tests. The surface must outlive the renderer.
+func CreateSoftwareRenderer(s *Surface) (*Renderer, error) {
+	defer lockThread()()
+
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
-	C.SDL_RenderClear(r.cRenderer)
+	renderer := C.SDL_CreateSoftwareRenderer(s.cSurface)
+	if renderer == nil {
+		return nil, sdlError()
+	}
+
+	return wrapRenderer(renderer), nil
 }
 
-func (r *Renderer) Copy(t *Texture, src, dst *Rect) {
+// Describes a rendering driver, or the driver of a renderer.
+type RendererInfo struct {
+	Name             string
+	Flags            uint32   // Combination of the RENDERER_* constants
+	TextureFormats   []uint32 // Supported PIXELFORMAT_* texture formats
+	MaxTextureWidth  int
+	MaxTextureHeight int
+}
+
+func wrapRendererInfo(cinfo *C.SDL_RendererInfo) *RendererInfo {
+	info := &RendererInfo{
+		Name:             C.GoString(cinfo.name),
+		Flags:            uint32(cinfo.flags),
+		TextureFormats:   make([]uint32, cinfo.num_texture_formats),
+		MaxTextureWidth:  int(cinfo.max_texture_width),
+		MaxTextureHeight: int(cinfo.max_texture_height),
+	}
+
+	for i := range info.TextureFormats {
+		info.TextureFormats[i] = uint32(cinfo.texture_formats[i])
+	}
+
+	return info
+}
+
+// Returns the number of 2D rendering drivers available for the current
+// display.
+func GetNumRenderDrivers() int {
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
-	C.SDL_RenderCopy(r.cRenderer, t.cTexture,
-		(*C.SDL_Rect)(cast(src)), (*C.SDL_Rect)(cast(dst)))
+	return int(C.SDL_GetNumRenderDrivers())
 }
 
-func (r *Renderer) Present() {
+// Gets information about a rendering driver, where index ranges from 0 to
+// GetNumRenderDrivers()-1.
+func GetRenderDriverInfo(index int) (*RendererInfo, error) {
+	defer lockThread()()
+
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
+	var cinfo C.SDL_RendererInfo
+	if C.SDL_GetRenderDriverInfo(C.int(index), &cinfo) != 0 {
+		return nil, sdlError()
+	}
+
+	return wrapRendererInfo(&cinfo), nil
+}
+
+// Gets information about the renderer's driver.
+func (r *Renderer) GetInfo() (*RendererInfo, error) {
+	defer lockThread()()
+
+	if err := r.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer r.mutex.Unlock()
+
+	var cinfo C.SDL_RendererInfo
+	if C.SDL_GetRendererInfo(r.cRenderer, &cinfo) != 0 {
+		return nil, sdlError()
+	}
+
+	return wrapRendererInfo(&cinfo), nil
+}
+
+func (r *Renderer) Clear() {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderClear(r.cRenderer)
+}
+
+func (r *Renderer) Copy(t *Texture, src, dst *Rect) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderCopy(r.cRenderer, t.handle(),
+		(*C.SDL_Rect)(cast(src)), (*C.SDL_Rect)(cast(dst)))
+}
+
+// Copies a portion of the texture to the current rendering target, rotated
+// clockwise by angle degrees around center and flipped according to flip
+// (a combination of the FLIP_* flags). A nil center rotates around the
+// center of dst.
+func (r *Renderer) CopyEx(t *Texture, src, dst *Rect, angle float64, center *Point, flip int) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderCopyEx(r.cRenderer, t.handle(),
+		(*C.SDL_Rect)(cast(src)), (*C.SDL_Rect)(cast(dst)), C.double(angle),
+		(*C.SDL_Point)(cast(center)), C.SDL_RendererFlip(flip))
+}
+
+// Executes the drawing commands the renderer has batched up. Only needed
+// by applications mixing the renderer with direct OpenGL (or other
+// graphics API) calls, before making those calls.
+// Returns 0 on success or a negative error code.
+func (r *Renderer) Flush() int {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return int(C.SDL_RenderFlush(r.cRenderer))
+}
+
+func (r *Renderer) Present() {
+	r.acquireWindow()
+	defer r.releaseWindow()
+
 	C.SDL_RenderPresent(r.cRenderer)
 }
 
 func (r *Renderer) SetDrawColor(c Color) {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+	r.acquire()
+	defer r.mutex.Unlock()
 
 	C.SDL_SetRenderDrawColor(r.cRenderer, C.Uint8(c.R),
 		C.Uint8(c.G), C.Uint8(c.B), C.Uint8(c.Alpha))
 }
 
-func (r *Renderer) FillRect(rect *Rect) {
+// Gets the color used for drawing operations.
+func (r *Renderer) GetDrawColor() Color {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	var red, green, blue, alpha C.Uint8
+	C.SDL_GetRenderDrawColor(r.cRenderer, &red, &green, &blue, &alpha)
+
+	return Color{R: uint8(red), G: uint8(green), B: uint8(blue), Alpha: uint8(alpha)}
+}
+
+// Sets how drawing operations (FillRect, DrawLine, ...) blend with the
+// target, one of the BLENDMODE_* constants or a mode made with
+// ComposeCustomBlendMode. Returns 0 on success or a negative error code.
+func (r *Renderer) SetDrawBlendMode(mode int) int {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return int(C.SDL_SetRenderDrawBlendMode(r.cRenderer, C.SDL_BlendMode(mode)))
+}
+
+// Gets how drawing operations blend with the target.
+func (r *Renderer) GetDrawBlendMode() int {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	var mode C.SDL_BlendMode
+	C.SDL_GetRenderDrawBlendMode(r.cRenderer, &mode)
+
+	return int(mode)
+}
+
+// Creates a blend mode from BLENDFACTOR_* and BLENDOPERATION_* constants,
+// for use with SetDrawBlendMode and Texture.SetBlendMode. The resulting
+// color is colorOperation(src*srcColorFactor, dst*dstColorFactor), and
+// likewise for alpha. For example premultiplied alpha blending is
+//
+//	ComposeCustomBlendMode(BLENDFACTOR_ONE, BLENDFACTOR_ONE_MINUS_SRC_ALPHA, BLENDOPERATION_ADD,
+//		BLENDFACTOR_ONE, BLENDFACTOR_ONE_MINUS_SRC_ALPHA, BLENDOPERATION_ADD)
+//
+// Not every renderer supports every combination; setting an unsupported
+// mode fails.
+func ComposeCustomBlendMode(srcColorFactor, dstColorFactor, colorOperation, srcAlphaFactor, dstAlphaFactor, alphaOperation int) int {
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
+	return int(C.SDL_ComposeCustomBlendMode(
+		C.SDL_BlendFactor(srcColorFactor), C.SDL_BlendFactor(dstColorFactor), C.SDL_BlendOperation(colorOperation),
+		C.SDL_BlendFactor(srcAlphaFactor), C.SDL_BlendFactor(dstAlphaFactor), C.SDL_BlendOperation(alphaOperation)))
+}
+
+func (r *Renderer) FillRect(rect *Rect) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
 	C.SDL_RenderFillRect(r.cRenderer, (*C.SDL_Rect)(cast(rect)))
 }
 
-func (r *Renderer) Destroy() {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Fills several rectangles with the drawing color in a single call.
+func (r *Renderer) FillRects(rects []Rect) {
+	if len(rects) == 0 {
+		return
+	}
 
-	C.SDL_DestroyRenderer(r.cRenderer)
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderFillRects(r.cRenderer, (*C.SDL_Rect)(cast(&rects[0])), C.int(len(rects)))
 }
 
-// =======
-// Texture
-// =======
+// Draws a point with the drawing color.
+func (r *Renderer) DrawPoint(x, y int) {
+	r.acquire()
+	defer r.mutex.Unlock()
 
-func CreateTexture(r *Renderer, format uint32, access, w, h int) *Texture {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+	C.SDL_RenderDrawPoint(r.cRenderer, C.int(x), C.int(y))
+}
 
-	texture := C.SDL_CreateTexture(r.cRenderer, C.Uint32(format),
-		C.int(access), C.int(w), C.int(h))
+// Draws several points with the drawing color in a single call.
+func (r *Renderer) DrawPoints(points []Point) {
+	if len(points) == 0 {
+		return
+	}
 
-	return wrapTexture(texture)
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawPoints(r.cRenderer, (*C.SDL_Point)(cast(&points[0])), C.int(len(points)))
 }
 
-func CreateTextureFromSurface(r *Renderer, s *Surface) *Texture {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Draws a line with the drawing color.
+func (r *Renderer) DrawLine(x1, y1, x2, y2 int) {
+	r.acquire()
+	defer r.mutex.Unlock()
 
-	texture := C.SDL_CreateTextureFromSurface(r.cRenderer, s.cSurface)
-	return wrapTexture(texture)
+	C.SDL_RenderDrawLine(r.cRenderer, C.int(x1), C.int(y1), C.int(x2), C.int(y2))
 }
 
-func (t *Texture) Update(rect *Rect, pixels interface{}, pitch int) {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Draws a connected series of lines through the points with the drawing
+// color in a single call.
+func (r *Renderer) DrawLines(points []Point) {
+	if len(points) == 0 {
+		return
+	}
 
-	C.SDL_UpdateTexture(t.cTexture, (*C.SDL_Rect)(cast(rect)), ptr(pixels), C.int(pitch))
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawLines(r.cRenderer, (*C.SDL_Point)(cast(&points[0])), C.int(len(points)))
 }
 
-func (t *Texture) Destroy() {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Draws the outline of a rectangle with the drawing color. A nil rect
+// outlines the entire rendering target.
+func (r *Renderer) DrawRect(rect *Rect) {
+	r.acquire()
+	defer r.mutex.Unlock()
 
-	C.SDL_DestroyTexture(t.cTexture)
+	C.SDL_RenderDrawRect(r.cRenderer, (*C.SDL_Rect)(cast(rect)))
 }
 
-// =======
-// General
-// =======
+// Draws the outlines of several rectangles with the drawing color in a
+// single call.
+func (r *Renderer) DrawRects(rects []Rect) {
+	if len(rects) == 0 {
+		return
+	}
 
-// The version of Go-SDL bindings.
-// The version descriptor changes into a new unique string
-// after a semantically incompatible Go-SDL update.
-//
-// The returned value can be checked by users of this package
-// to make sure they are using a version with the expected semantics.
-//
-// If Go adds some kind of support for package versioning, this function will go away.
-func GoSdlVersion() string {
-	return "⚛SDL bindings 1.0"
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawRects(r.cRenderer, (*C.SDL_Rect)(cast(&rects[0])), C.int(len(rects)))
 }
 
-// Initializes SDL.
-func Init(flags uint32) int {
-	GlobalMutex.Lock()
-	status := int(C.SDL_Init(C.Uint32(flags)))
-	if (status != 0) && (runtime.GOOS == "darwin") && (flags&INIT_VIDEO != 0) {
-		if os.Getenv("SDL_VIDEODRIVER") == "" {
-			os.Setenv("SDL_VIDEODRIVER", "x11")
-			status = int(C.SDL_Init(C.Uint32(flags)))
-			if status != 0 {
-				os.Setenv("SDL_VIDEODRIVER", "")
-			}
-		}
+// Like Copy, but with a floating point destination rectangle for
+// sub-pixel positioning.
+func (r *Renderer) CopyF(t *Texture, src *Rect, dst *FRect) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderCopyF(r.cRenderer, t.handle(),
+		(*C.SDL_Rect)(cast(src)), (*C.SDL_FRect)(cast(dst)))
+}
+
+// Like CopyEx, but with a floating point destination rectangle and
+// center point.
+func (r *Renderer) CopyExF(t *Texture, src *Rect, dst *FRect, angle float64, center *FPoint, flip int) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderCopyExF(r.cRenderer, t.handle(),
+		(*C.SDL_Rect)(cast(src)), (*C.SDL_FRect)(cast(dst)), C.double(angle),
+		(*C.SDL_FPoint)(cast(center)), C.SDL_RendererFlip(flip))
+}
+
+// Draws a point at floating point coordinates with the drawing color.
+func (r *Renderer) DrawPointF(x, y float32) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawPointF(r.cRenderer, C.float(x), C.float(y))
+}
+
+// Draws several points at floating point coordinates with the drawing
+// color in a single call.
+func (r *Renderer) DrawPointsF(points []FPoint) {
+	if len(points) == 0 {
+		return
 	}
 
-	GlobalMutex.Unlock()
-	return status
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawPointsF(r.cRenderer, (*C.SDL_FPoint)(cast(&points[0])), C.int(len(points)))
 }
 
-// Shuts down SDL
-func Quit() {
-	GlobalMutex.Lock()
+// Draws a line between floating point coordinates with the drawing color.
+func (r *Renderer) DrawLineF(x1, y1, x2, y2 float32) {
+	r.acquire()
+	defer r.mutex.Unlock()
 
-	if currentVideoSurface != nil {
-		currentVideoSurface.destroy()
-		currentVideoSurface = nil
+	C.SDL_RenderDrawLineF(r.cRenderer, C.float(x1), C.float(y1), C.float(x2), C.float(y2))
+}
+
+// Draws a connected series of lines through floating point coordinates
+// with the drawing color in a single call.
+func (r *Renderer) DrawLinesF(points []FPoint) {
+	if len(points) == 0 {
+		return
 	}
 
-	C.SDL_Quit()
+	r.acquire()
+	defer r.mutex.Unlock()
 
-	GlobalMutex.Unlock()
+	C.SDL_RenderDrawLinesF(r.cRenderer, (*C.SDL_FPoint)(cast(&points[0])), C.int(len(points)))
 }
 
-// Initializes subsystems.
-func InitSubSystem(flags uint32) int {
-	GlobalMutex.Lock()
-	status := int(C.SDL_InitSubSystem(C.Uint32(flags)))
-	if (status != 0) && (runtime.GOOS == "darwin") && (flags&INIT_VIDEO != 0) {
-		if os.Getenv("SDL_VIDEODRIVER") == "" {
-			os.Setenv("SDL_VIDEODRIVER", "x11")
-			status = int(C.SDL_InitSubSystem(C.Uint32(flags)))
-			if status != 0 {
-				os.Setenv("SDL_VIDEODRIVER", "")
-			}
-		}
+// Draws the outline of a floating point rectangle with the drawing color.
+// A nil rect outlines the entire rendering target.
+func (r *Renderer) DrawRectF(rect *FRect) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawRectF(r.cRenderer, (*C.SDL_FRect)(cast(rect)))
+}
+
+// Draws the outlines of several floating point rectangles with the
+// drawing color in a single call.
+func (r *Renderer) DrawRectsF(rects []FRect) {
+	if len(rects) == 0 {
+		return
 	}
-	GlobalMutex.Unlock()
-	return status
+
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderDrawRectsF(r.cRenderer, (*C.SDL_FRect)(cast(&rects[0])), C.int(len(rects)))
 }
 
-// Shuts down a subsystem.
-func QuitSubSystem(flags uint32) {
-	GlobalMutex.Lock()
-	C.SDL_QuitSubSystem(C.Uint32(flags))
-	GlobalMutex.Unlock()
+// Fills a floating point rectangle with the drawing color. A nil rect
+// fills the entire rendering target.
+func (r *Renderer) FillRectF(rect *FRect) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderFillRectF(r.cRenderer, (*C.SDL_FRect)(cast(rect)))
 }
 
-// Checks which subsystems are initialized.
-func WasInit(flags uint32) int {
-	GlobalMutex.Lock()
-	status := int(C.SDL_WasInit(C.Uint32(flags)))
-	GlobalMutex.Unlock()
-	return status
+// Fills several floating point rectangles with the drawing color in a
+// single call.
+func (r *Renderer) FillRectsF(rects []FRect) {
+	if len(rects) == 0 {
+		return
+	}
+
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	C.SDL_RenderFillRectsF(r.cRenderer, (*C.SDL_FRect)(cast(&rects[0])), C.int(len(rects)))
 }
 
-func NumDisplayModes(index int) int {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Gets the window the renderer draws to, or nil for a software renderer.
+func (r *Renderer) GetWindow() *Window {
+	r.acquire()
+	defer r.mutex.Unlock()
 
-	return int(C.SDL_GetNumDisplayModes(C.int(index)))
+	return wrapWindow(C.SDL_RenderGetWindow(r.cRenderer))
 }
 
-// ==============
-// Error Handling
-// ==============
+// Gets the size of the renderer's output in pixels, which is larger than
+// the window size in screen coordinates on high-DPI displays.
+func (r *Renderer) GetOutputSize() (int, int, error) {
+	defer lockThread()()
 
-// Gets SDL error string
-func GetError() string {
-	GlobalMutex.Lock()
-	s := C.GoString(C.SDL_GetError())
-	GlobalMutex.Unlock()
-	return s
+	if err := r.tryAcquireWindow(); err != nil {
+		return 0, 0, err
+	}
+	defer r.releaseWindow()
+
+	var w, h C.int
+	if C.SDL_GetRendererOutputSize(r.cRenderer, &w, &h) != 0 {
+		return 0, 0, sdlError()
+	}
+
+	return int(w), int(h), nil
 }
 
-// Clear the current SDL error
-func ClearError() {
-	GlobalMutex.Lock()
-	C.SDL_ClearError()
-	GlobalMutex.Unlock()
+// Gets the size of the renderer's output in pixels, or 0, 0 on error.
+func (r *Renderer) outputSize() (int, int) {
+	w, h, _ := r.GetOutputSize()
+	return w, h
 }
 
-// ======
-// Window
-// ======
+// Reads an area of the current rendering target into a new buffer,
+// converting the pixels to the given PIXELFORMAT_* format, and returns the
+// buffer and the number of bytes between its rows. rect is in pixels of
+// the target and is clipped to the viewport; if rect is nil, the whole
+// viewport is read, which is only the whole target when no viewport or
+// logical size is set (Screenshot always reads the whole target).
+// This is slow, it should not be used every frame.
+func (r *Renderer) ReadPixels(rect *Rect, format uint32) ([]byte, int, error) {
+	defer lockThread()()
 
-func CreateWindow(title string, x, y int, h, w int, flags uint32) *Window {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+	if rect == nil {
+		viewport, err := r.viewportPixels()
+		if err != nil {
+			return nil, 0, err
+		}
+		// SDL reads the same area without a rect, but passing it makes
+		// sure SDL never writes more than the buffer is sized for
+		rect = &viewport
+	}
 
-	window := C.SDL_CreateWindow(C.CString(title), C.int(x), C.int(y),
-		C.int(h), C.int(w), C.Uint32(flags))
+	w, h := int(rect.W), int(rect.H)
+	bpp := bytesPerPixel(format)
+	if w <= 0 || h <= 0 || bpp == 0 {
+		return nil, 0, errors.New("sdl: nothing to read")
+	}
 
-	return wrapWindow(window)
+	pitch := w * bpp
+	pixels := make([]byte, pitch*h)
+	if r.readPixels(rect, format, pixels, pitch) != 0 {
+		return nil, 0, errors.New(GetError())
+	}
+
+	return pixels, pitch, nil
 }
 
-func CreateWindowAndRenderer(h, w int, flags uint32) (*Window, *Renderer) {
-	var win Window
-	var rend Renderer
+// Gets the viewport in pixels of the rendering target rather than in
+// scaled coordinates.
+func (r *Renderer) viewportPixels() (Rect, error) {
+	if err := r.tryAcquire(); err != nil {
+		return Rect{}, err
+	}
+	defer r.mutex.Unlock()
 
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+	var viewport C.SDL_Rect
+	var scaleX, scaleY C.float
+	C.SDL_RenderGetViewport(r.cRenderer, &viewport)
+	C.SDL_RenderGetScale(r.cRenderer, &scaleX, &scaleY)
 
-	C.SDL_CreateWindowAndRenderer(C.int(h), C.int(w), C.Uint32(flags),
-		&win.cWindow, &rend.cRenderer)
+	return Rect{
+		X: int32(float32(viewport.x) * float32(scaleX)),
+		Y: int32(float32(viewport.y) * float32(scaleY)),
+		W: int32(float32(viewport.w) * float32(scaleX)),
+		H: int32(float32(viewport.h) * float32(scaleY)),
+	}, nil
+}
+
+// Reads pixels of the current rendering target into a buffer.
+// Returns 0 on success or a negative error code.
+func (r *Renderer) readPixels(rect *Rect, format uint32, pixels []byte, pitch int) int {
+	r.acquireWindow()
+	defer r.releaseWindow()
 
-	return &win, &rend
+	return int(C.SDL_RenderReadPixels(r.cRenderer, (*C.SDL_Rect)(cast(rect)),
+		C.Uint32(format), unsafe.Pointer(&pixels[0]), C.int(pitch)))
 }
 
-func (w *Window) GetTitle() string {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Turns vertical sync on or off for the renderer, without having to
+// recreate it. Returns 0 on success or a negative error code.
+func (r *Renderer) SetVSync(on bool) int {
+	r.acquireWindow()
+	defer r.releaseWindow()
 
-	ctitle := C.SDL_GetWindowTitle(w.cWindow)
+	vsync := 0
+	if on {
+		vsync = 1
+	}
 
-	return C.GoString(ctitle)
+	return int(C.SDL_RenderSetVSync(r.cRenderer, C.int(vsync)))
 }
 
-func (w *Window) SetTitle(title string) {
-	GlobalMutex.Lock()
-	defer GlobalMutex.Unlock()
+// Sets a device independent resolution for rendering. Drawing happens at
+// w x h and is scaled to the output, letterboxed if the aspect ratios
+// differ. Passing 0, 0 disables the logical size.
+// Returns 0 on success or a negative error code.
+func (r *Renderer) SetLogicalSize(w, h int) int {
+	r.acquireWindow()
+	defer r.releaseWindow()
 
-	ctitle := C.CString(title)
-	C.SDL_SetWindowTitle(w.cWindow, ctitle)
+	return int(C.SDL_RenderSetLogicalSize(r.cRenderer, C.int(w), C.int(h)))
+}
+
+// Gets the device independent resolution, or 0, 0 if none is set.
+func (r *Renderer) GetLogicalSize() (w, h int) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	var cw, ch C.int
+	C.SDL_RenderGetLogicalSize(r.cRenderer, &cw, &ch)
+
+	return int(cw), int(ch)
+}
+
+// Restricts scaling to the logical size to whole multiples, which keeps
+// pixel art sharp at the cost of larger borders.
+// Returns 0 on success or a negative error code.
+func (r *Renderer) SetIntegerScale(enable bool) int {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return int(C.SDL_RenderSetIntegerScale(r.cRenderer, cbool(enable)))
+}
+
+// Checks whether scaling is restricted to whole multiples.
+func (r *Renderer) GetIntegerScale() bool {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return C.SDL_RenderGetIntegerScale(r.cRenderer) == C.SDL_TRUE
+}
+
+// Gets the horizontal and vertical drawing scale, which SetLogicalSize
+// also changes.
+func (r *Renderer) GetScale() (scaleX, scaleY float32) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	var x, y C.float
+	C.SDL_RenderGetScale(r.cRenderer, &x, &y)
+
+	return float32(x), float32(y)
+}
+
+// Checks whether clipping is enabled on the current render target.
+func (r *Renderer) IsClipEnabled() bool {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return C.SDL_RenderIsClipEnabled(r.cRenderer) == C.SDL_TRUE
+}
+
+// Maps a point in window coordinates, such as the position of a mouse
+// event, into the logical rendering space set up by SetLogicalSize and
+// scaling.
+func (r *Renderer) WindowToLogical(windowX, windowY int) (logicalX, logicalY float32) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	var x, y C.float
+	C.SDL_RenderWindowToLogical(r.cRenderer, C.int(windowX), C.int(windowY), &x, &y)
+
+	return float32(x), float32(y)
+}
+
+// Maps a point in the logical rendering space to window coordinates.
+func (r *Renderer) LogicalToWindow(logicalX, logicalY float32) (windowX, windowY int) {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	var x, y C.int
+	C.SDL_RenderLogicalToWindow(r.cRenderer, C.float(logicalX), C.float(logicalY), &x, &y)
+
+	return int(x), int(y)
+}
+
+// Checks whether the renderer supports rendering into textures.
+func (r *Renderer) RenderTargetSupported() bool {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return C.SDL_RenderTargetSupported(r.cRenderer) == C.SDL_TRUE
+}
+
+// Makes the texture the target of subsequent rendering, or the window
+// again if t is nil. The texture must have been created with
+// TEXTUREACCESS_TARGET. Returns 0 on success or a negative error code.
+func (r *Renderer) SetRenderTarget(t *Texture) int {
+	r.acquireWindow()
+	defer r.releaseWindow()
+
+	var texture *C.SDL_Texture
+	if t != nil {
+		texture = t.handle()
+	}
+
+	status := int(C.SDL_SetRenderTarget(r.cRenderer, texture))
+	if status == 0 {
+		r.shared.target = t
+	}
+
+	return status
+}
+
+// Gets the current render target, or nil if rendering goes to the window.
+func (r *Renderer) GetRenderTarget() *Texture {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	return wrapTexture(C.SDL_GetRenderTarget(r.cRenderer), r.shared)
+}
+
+// Destroys the renderer and its textures. Does nothing if the renderer
+// has already been destroyed.
+func (r *Renderer) Destroy() {
+	if r.tryAcquireWindow() != nil {
+		return
+	}
+	defer r.releaseWindow()
+
+	renderersLock.Lock()
+	delete(renderers, r.cRenderer)
+	renderersLock.Unlock()
+
+	C.SDL_DestroyRenderer(r.cRenderer)
+	r.shared.destroyed = true
+}
+
+// =======
+// Texture
+// =======
+
+// Creates a texture for a renderer. access is one of the TEXTUREACCESS_*
+// constants: static textures change rarely, streaming textures are
+// updated often, and target textures can be rendered into after
+// Renderer.SetRenderTarget. Returns nil on error.
+func CreateTexture(r *Renderer, format uint32, access, w, h int) *Texture {
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	texture := C.SDL_CreateTexture(r.cRenderer, C.Uint32(format),
+		C.int(access), C.int(w), C.int(h))
+
+	return wrapTexture(texture, r.shared)
+}
+
+// Creates a texture with the contents of a surface. Costly conversions are
+// reported to the asset warning handler (see SetAssetWarningHandler).
+// Returns nil on error.
+func CreateTextureFromSurface(r *Renderer, s *Surface) *Texture {
+	s.check()
+	checkAsset(r, s.Format.Format, int(s.W), int(s.H))
+
+	r.acquire()
+	defer r.mutex.Unlock()
+
+	texture := C.SDL_CreateTextureFromSurface(r.cRenderer, s.cSurface)
+	return wrapTexture(texture, r.shared)
+}
+
+// Replaces an area of the texture (the whole texture if rect is nil) with
+// new pixel data in the texture's format. pixels is a pointer, slice or
+// uintptr as accepted by CreateRGBSurfaceFrom, and pitch is the number of
+// bytes between its rows. For streaming textures updated every frame,
+// Lock and Unlock avoid a copy.
+func (t *Texture) Update(rect *Rect, pixels interface{}, pitch int) {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	C.SDL_UpdateTexture(t.cTexture, (*C.SDL_Rect)(cast(rect)), ptr(pixels), C.int(pitch))
+}
+
+// Updates an area of a PIXELFORMAT_YV12 or PIXELFORMAT_IYUV texture (the
+// whole texture if rect is nil) from separate Y, U and V planes, each with
+// its own pitch. Returns 0 on success or a negative error code.
+func (t *Texture) UpdateYUV(rect *Rect, yPlane []byte, yPitch int, uPlane []byte, uPitch int, vPlane []byte, vPitch int) int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	return int(C.SDL_UpdateYUVTexture(t.cTexture, (*C.SDL_Rect)(cast(rect)),
+		(*C.Uint8)(ptr(yPlane)), C.int(yPitch),
+		(*C.Uint8)(ptr(uPlane)), C.int(uPitch),
+		(*C.Uint8)(ptr(vPlane)), C.int(vPitch)))
+}
+
+// Updates an area of a PIXELFORMAT_NV12 or PIXELFORMAT_NV21 texture (the
+// whole texture if rect is nil) from a Y plane and an interleaved UV (or
+// VU) plane. Returns 0 on success or a negative error code.
+func (t *Texture) UpdateNV(rect *Rect, yPlane []byte, yPitch int, uvPlane []byte, uvPitch int) int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	return int(C.SDL_UpdateNVTexture(t.cTexture, (*C.SDL_Rect)(cast(rect)),
+		(*C.Uint8)(ptr(yPlane)), C.int(yPitch),
+		(*C.Uint8)(ptr(uvPlane)), C.int(uvPitch)))
+}
+
+// Gets the pixel format, access (one of the TEXTUREACCESS_* constants) and
+// size of the texture.
+func (t *Texture) Query() (format uint32, access int, w, h int, err error) {
+	defer lockThread()()
+
+	if err := t.tryAcquire(); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	defer t.mutex.Unlock()
+
+	var cformat C.Uint32
+	var caccess, cw, ch C.int
+	if C.SDL_QueryTexture(t.cTexture, &cformat, &caccess, &cw, &ch) != 0 {
+		return 0, 0, 0, 0, sdlError()
+	}
+
+	return uint32(cformat), int(caccess), int(cw), int(ch), nil
+}
+
+// Gets the size of the texture, or 0, 0 if it cannot be queried.
+func (t *Texture) Size() (w, h int) {
+	_, _, w, h, _ = t.Query()
+	return w, h
+}
+
+// Sets how the texture is blended when copied, one of the BLENDMODE_*
+// constants or a mode made with ComposeCustomBlendMode.
+// Returns 0 on success or a negative error code.
+func (t *Texture) SetBlendMode(mode int) int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	return int(C.SDL_SetTextureBlendMode(t.cTexture, C.SDL_BlendMode(mode)))
+}
+
+// Gets the blend mode used when copying the texture.
+func (t *Texture) GetBlendMode() int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	var mode C.SDL_BlendMode
+	C.SDL_GetTextureBlendMode(t.cTexture, &mode)
+
+	return int(mode)
+}
+
+// Sets a value multiplied into the alpha of the texture when it is copied.
+// Returns 0 on success or a negative error code.
+func (t *Texture) SetAlphaMod(alpha uint8) int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	return int(C.SDL_SetTextureAlphaMod(t.cTexture, C.Uint8(alpha)))
+}
+
+// Gets the value multiplied into the alpha of the texture.
+func (t *Texture) GetAlphaMod() uint8 {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	var alpha C.Uint8
+	C.SDL_GetTextureAlphaMod(t.cTexture, &alpha)
+
+	return uint8(alpha)
+}
+
+// Sets a color multiplied into the texture when it is copied, tinting it.
+// Returns 0 on success or a negative error code.
+func (t *Texture) SetColorMod(r, g, b uint8) int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	return int(C.SDL_SetTextureColorMod(t.cTexture, C.Uint8(r), C.Uint8(g), C.Uint8(b)))
+}
+
+// Gets the color multiplied into the texture.
+func (t *Texture) GetColorMod() (r, g, b uint8) {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	var cr, cg, cb C.Uint8
+	C.SDL_GetTextureColorMod(t.cTexture, &cr, &cg, &cb)
+
+	return uint8(cr), uint8(cg), uint8(cb)
+}
+
+// Sets how the texture is filtered when scaled, one of the SCALEMODE_*
+// constants. Returns 0 on success or a negative error code.
+func (t *Texture) SetScaleMode(mode int) int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	return int(C.SDL_SetTextureScaleMode(t.cTexture, C.SDL_ScaleMode(mode)))
+}
+
+// Gets how the texture is filtered when scaled.
+func (t *Texture) GetScaleMode() int {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	var mode C.SDL_ScaleMode
+	C.SDL_GetTextureScaleMode(t.cTexture, &mode)
+
+	return int(mode)
+}
+
+// Destroys the texture. Does nothing if the texture, or its renderer, has
+// already been destroyed.
+func (t *Texture) Destroy() {
+	if t.tryAcquire() != nil {
+		return
+	}
+	defer t.mutex.Unlock()
+
+	C.SDL_DestroyTexture(t.cTexture)
+	t.cTexture = nil
+}
+
+// =======
+// General
+// =======
+
+// The version of Go-SDL bindings.
+// The version descriptor changes into a new unique string
+// after a semantically incompatible Go-SDL update.
+//
+// The returned value can be checked by users of this package
+// to make sure they are using a version with the expected semantics.
+//
+// If Go adds some kind of support for package versioning, this function will go away.
+func GoSdlVersion() string {
+	return "⚛SDL bindings 1.0"
+}
+
+// Initializes SDL.
+func Init(flags uint32) int {
+	if loadErr != nil {
+		return -1
+	}
+
+	GlobalMutex.Lock()
+	status := int(C.SDL_Init(C.Uint32(flags)))
+	if (status != 0) && (runtime.GOOS == "darwin") && (flags&INIT_VIDEO != 0) {
+		if os.Getenv("SDL_VIDEODRIVER") == "" {
+			os.Setenv("SDL_VIDEODRIVER", "x11")
+			status = int(C.SDL_Init(C.Uint32(flags)))
+			if status != 0 {
+				os.Setenv("SDL_VIDEODRIVER", "")
+			}
+		}
+	}
+
+	GlobalMutex.Unlock()
+	return status
+}
+
+// Shuts down SDL
+func Quit() {
+	GlobalMutex.Lock()
+
+	if currentVideoSurface != nil {
+		currentVideoSurface.destroy()
+		currentVideoSurface = nil
+	}
+
+	C.SDL_Quit()
+
+	GlobalMutex.Unlock()
+}
+
+// Initializes subsystems.
+func InitSubSystem(flags uint32) int {
+	if loadErr != nil {
+		return -1
+	}
+
+	GlobalMutex.Lock()
+	status := int(C.SDL_InitSubSystem(C.Uint32(flags)))
+	if (status != 0) && (runtime.GOOS == "darwin") && (flags&INIT_VIDEO != 0) {
+		if os.Getenv("SDL_VIDEODRIVER") == "" {
+			os.Setenv("SDL_VIDEODRIVER", "x11")
+			status = int(C.SDL_InitSubSystem(C.Uint32(flags)))
+			if status != 0 {
+				os.Setenv("SDL_VIDEODRIVER", "")
+			}
+		}
+	}
+	GlobalMutex.Unlock()
+	return status
+}
+
+// Shuts down a subsystem.
+func QuitSubSystem(flags uint32) {
+	GlobalMutex.Lock()
+	C.SDL_QuitSubSystem(C.Uint32(flags))
+	GlobalMutex.Unlock()
+}
+
+// Checks which subsystems are initialized.
+func WasInit(flags uint32) int {
+	GlobalMutex.Lock()
+	status := int(C.SDL_WasInit(C.Uint32(flags)))
+	GlobalMutex.Unlock()
+	return status
+}
+
+// Returns the number of available video displays.
+func GetNumVideoDisplays() int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetNumVideoDisplays())
+}
+
+// Gets the desktop area represented by a display, with the primary
+// display located at 0,0. Returns 0 on success or a negative error code.
+func GetDisplayBounds(index int, rect *Rect) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetDisplayBounds(C.int(index), (*C.SDL_Rect)(cast(rect))))
+}
+
+// Gets the name of a display, or "" on error.
+func GetDisplayName(index int) string {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.GoString(C.SDL_GetDisplayName(C.int(index)))
+}
+
+// Gets the usable desktop area of a display, which excludes areas reserved
+// by the system such as the taskbar or menu bar.
+// Returns 0 on success or a negative error code.
+func GetDisplayUsableBounds(index int, rect *Rect) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetDisplayUsableBounds(C.int(index), (*C.SDL_Rect)(cast(rect))))
+}
+
+// Gets the diagonal, horizontal and vertical dots per inch of a display.
+// Returns 0 on success or a negative error code.
+func GetDisplayDPI(index int, ddpi, hdpi, vdpi *float32) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetDisplayDPI(C.int(index),
+		(*C.float)(cast(ddpi)), (*C.float)(cast(hdpi)), (*C.float)(cast(vdpi))))
+}
+
+// Gets one of the display modes of a display, where modeIndex ranges from 0
+// to NumDisplayModes(displayIndex)-1. Modes are sorted by size, pixel depth
+// and refresh rate, largest first.
+// Returns 0 on success or a negative error code.
+func GetDisplayMode(displayIndex, modeIndex int, mode *DisplayMode) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetDisplayMode(C.int(displayIndex), C.int(modeIndex),
+		(*C.SDL_DisplayMode)(cast(mode))))
+}
+
+// Gets the current display mode of a display, which differs from the
+// desktop mode while a fullscreen window uses another mode.
+// Returns 0 on success or a negative error code.
+func GetCurrentDisplayMode(index int, mode *DisplayMode) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetCurrentDisplayMode(C.int(index), (*C.SDL_DisplayMode)(cast(mode))))
+}
+
+// Gets the display mode the desktop of a display uses.
+// Returns 0 on success or a negative error code.
+func GetDesktopDisplayMode(index int, mode *DisplayMode) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetDesktopDisplayMode(C.int(index), (*C.SDL_DisplayMode)(cast(mode))))
+}
+
+// Returns all display modes of a display, or nil on error.
+func GetDisplayModes(index int) []DisplayMode {
+	n := NumDisplayModes(index)
+	if n < 0 {
+		return nil
+	}
+
+	modes := make([]DisplayMode, n)
+	for i := range modes {
+		if GetDisplayMode(index, i, &modes[i]) != 0 {
+			return nil
+		}
+	}
+
+	return modes
+}
+
+// Returns the number of display modes available on a display.
+func NumDisplayModes(index int) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetNumDisplayModes(C.int(index)))
+}
+
+// Allows the screen to be blanked by a screensaver.
+func EnableScreenSaver() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_EnableScreenSaver()
+}
+
+// Prevents the screen from being blanked by a screensaver. SDL disables
+// the screensaver by default when the video subsystem is initialized.
+func DisableScreenSaver() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_DisableScreenSaver()
+}
+
+// Checks whether the screensaver is currently enabled.
+func IsScreenSaverEnabled() bool {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_IsScreenSaverEnabled() == C.SDL_TRUE
+}
+
+// Calculates a 256 entry gamma ramp for a gamma value, where 1.0 is the
+// identity ramp.
+func CalculateGammaRamp(gamma float32, ramp *[256]uint16) {
+	C.SDL_CalculateGammaRamp(C.float(gamma), (*C.Uint16)(cast(ramp)))
+}
+
+// ==============
+// Error Handling
+// ==============
+
+// Size of the buffers SDL error messages are copied into.
+const errorBufferSize = 1024
+
+// Copies the current SDL error message of the calling thread.
+// SDL_GetError returns a pointer into a buffer that the next failing call
+// overwrites, so the message is copied into a buffer of our own.
+func errorMessage() string {
+	if loadErr != nil {
+		return loadErr.Error()
+	}
+
+	var buf [errorBufferSize]C.char
+	return C.GoString(C.getErrorMsg(&buf[0], C.int(len(buf))))
+}
+
+// Locks the calling goroutine to its OS thread until the returned
+// function is called. SDL keeps the error message per thread, so functions
+// reporting a failure with sdlError start with
+//
+//	defer lockThread()()
+//
+// to fetch the message on the thread the failing call ran on.
+func lockThread() func() {
+	runtime.LockOSThread()
+	return runtime.UnlockOSThread
+}
+
+// Gets SDL error string. SDL keeps one message per OS thread: unless the
+// goroutine is locked to its thread (see runtime.LockOSThread), the
+// message may come from another thread than the failing call.
+func GetError() string {
+	GlobalMutex.Lock()
+	s := errorMessage()
+	GlobalMutex.Unlock()
+	return s
+}
+
+// Wraps the current SDL error string in an error value.
+// Must be called right after the failed call, with the lock taken for it
+// (GlobalMutex, or the lock of a renderer) still held, from a function
+// that locked the goroutine to its thread with lockThread.
+func sdlError() error {
+	return errors.New(errorMessage())
+}
+
+// Clear the current SDL error
+func ClearError() {
+	GlobalMutex.Lock()
+	C.SDL_ClearError()
+	GlobalMutex.Unlock()
+}
+
+// ======
+// Window
+// ======
+
+func CreateWindow(title string, x, y int, h, w int, flags uint32) *Window {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	window := C.SDL_CreateWindow(C.CString(title), C.int(x), C.int(y),
+		C.int(h), C.int(w), C.Uint32(flags))
+
+	return wrapWindow(window)
+}
+
+func CreateWindowAndRenderer(h, w int, flags uint32) (*Window, *Renderer) {
+	var win Window
+	var cRenderer *C.SDL_Renderer
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_CreateWindowAndRenderer(C.int(h), C.int(w), C.Uint32(flags),
+		&win.cWindow, &cRenderer)
+
+	return &win, wrapRenderer(cRenderer)
+}
+
+// Gets the numeric ID of the window, as found in the WindowId field of
+// events.
+func (w *Window) GetID() uint32 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return uint32(C.SDL_GetWindowID(w.cWindow))
+}
+
+// Gets the window with the given ID, or nil if there is none.
+func GetWindowFromID(id uint32) *Window {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return wrapWindow(C.SDL_GetWindowFromID(C.Uint32(id)))
+}
+
+// Gets the size of the window's client area in screen coordinates.
+func (w *Window) GetSize() (int, int) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var width, height C.int
+	C.SDL_GetWindowSize(w.cWindow, &width, &height)
+
+	return int(width), int(height)
+}
+
+// Gets the renderer created for the window, or nil if it has none.
+func (w *Window) GetRenderer() *Renderer {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return wrapRenderer(C.SDL_GetRenderer(w.cWindow))
+}
+
+// Gets the index of the display containing the center of the window,
+// or -1 on error.
+func (w *Window) GetDisplayIndex() int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetWindowDisplayIndex(w.cWindow))
+}
+
+// Gets the size of the window's client area in pixels, which differs
+// from its size in screen coordinates on high-DPI displays if the window
+// was created with WINDOW_ALLOW_HIGHDPI.
+func (w *Window) drawableSize() (int, int) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var width, height C.int
+	if renderer := C.SDL_GetRenderer(w.cWindow); renderer != nil {
+		C.SDL_GetRendererOutputSize(renderer, &width, &height)
+	} else if C.SDL_GetWindowFlags(w.cWindow)&C.SDL_WINDOW_OPENGL != 0 {
+		C.SDL_GL_GetDrawableSize(w.cWindow, &width, &height)
+	} else {
+		C.SDL_GetWindowSize(w.cWindow, &width, &height)
+	}
+
+	return int(width), int(height)
+}
+
+// Creates a window that starts out hidden and stays off the taskbar, for
+// programs that run in the background (playing music, listening for
+// hotkeys) and only show a window on demand with Show. w and h give the
+// size of the window once shown. Returns nil on error.
+func CreateBackgroundWindow(title string, w, h int) *Window {
+	return CreateWindow(title, WINDOWPOS_UNDEFINED, WINDOWPOS_UNDEFINED, w, h,
+		WINDOW_HIDDEN|WINDOW_SKIP_TASKBAR)
+}
+
+// Shows the window.
+func (w *Window) Show() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_ShowWindow(w.cWindow)
+}
+
+// Hides the window.
+func (w *Window) Hide() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_HideWindow(w.cWindow)
+}
+
+// Raises the window above other windows and sets the input focus.
+func (w *Window) Raise() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_RaiseWindow(w.cWindow)
+}
+
+// Gets the window's flags, a combination of the WINDOW_* constants.
+func (w *Window) GetFlags() uint32 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return uint32(C.SDL_GetWindowFlags(w.cWindow))
+}
+
+func (w *Window) GetTitle() string {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	ctitle := C.SDL_GetWindowTitle(w.cWindow)
+
+	return C.GoString(ctitle)
+}
+
+func (w *Window) SetTitle(title string) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	ctitle := C.CString(title)
+	C.SDL_SetWindowTitle(w.cWindow, ctitle)
+
+	C.free(unsafe.Pointer(ctitle))
+}
+
+func (w *Window) SetIcon(s *Surface) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_SetWindowIcon(w.cWindow, s.cSurface)
+}
+
+func (w *Window) SetFullscreen(flags uint32) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_SetWindowFullscreen(w.cWindow, C.Uint32(flags))
+}
+
+// Sets the window's input grab mode. When input is grabbed the mouse is
+// confined to the window.
+func (w *Window) SetGrab(grabbed bool) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_SetWindowGrab(w.cWindow, cbool(grabbed))
+}
+
+// Gets the window's input grab mode.
+func (w *Window) GetGrab() bool {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_GetWindowGrab(w.cWindow) == C.SDL_TRUE
+}
+
+// Sets the window's keyboard grab mode. When keyboard is grabbed, system
+// keyboard shortcuts (such as Alt+Tab or the Meta key) are captured by the
+// window while it has input focus.
+func (w *Window) SetKeyboardGrab(grabbed bool) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_SetWindowKeyboardGrab(w.cWindow, cbool(grabbed))
+}
+
+// Gets the window's keyboard grab mode.
+func (w *Window) GetKeyboardGrab() bool {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_GetWindowKeyboardGrab(w.cWindow) == C.SDL_TRUE
+}
+
+// Sets whether the window stays on top of all other windows.
+func (w *Window) SetAlwaysOnTop(onTop bool) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_SetWindowAlwaysOnTop(w.cWindow, cbool(onTop))
+}
+
+// Sets the opacity of the window, from 0.0 (transparent) to 1.0 (opaque).
+// Returns 0 on success or -1 if setting the opacity isn't supported.
+func (w *Window) SetOpacity(opacity float32) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_SetWindowOpacity(w.cWindow, C.float(opacity)))
+}
+
+// Gets the opacity of the window. Windows on platforms without opacity
+// support always report 1.0.
+func (w *Window) GetOpacity() float32 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	opacity := C.float(1.0)
+	C.SDL_GetWindowOpacity(w.cWindow, &opacity)
+
+	return float32(opacity)
+}
+
+// Sets the brightness (gamma multiplier) for the display that owns the
+// window, where 0.0 is completely dark and 1.0 is normal brightness.
+// Returns 0 on success or a negative error code.
+func (w *Window) SetBrightness(brightness float32) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_SetWindowBrightness(w.cWindow, C.float(brightness)))
+}
+
+// Gets the brightness (gamma multiplier) for the display that owns the window.
+func (w *Window) GetBrightness() float32 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return float32(C.SDL_GetWindowBrightness(w.cWindow))
+}
+
+// Sets the gamma ramp for the display that owns the window. Any of the
+// tables may be nil to leave that channel unchanged.
+// Returns 0 on success or a negative error code.
+func (w *Window) SetGammaRamp(red, green, blue *[256]uint16) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_SetWindowGammaRamp(w.cWindow,
+		(*C.Uint16)(cast(red)), (*C.Uint16)(cast(green)), (*C.Uint16)(cast(blue))))
+}
+
+// Gets the gamma ramp for the display that owns the window. Any of the
+// tables may be nil if that channel isn't needed.
+// Returns 0 on success or a negative error code.
+func (w *Window) GetGammaRamp(red, green, blue *[256]uint16) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GetWindowGammaRamp(w.cWindow,
+		(*C.Uint16)(cast(red)), (*C.Uint16)(cast(green)), (*C.Uint16)(cast(blue))))
+}
+
+// Makes the window modal for the parent window: the parent cannot be
+// interacted with while the window is shown. Only supported on X11.
+// Returns 0 on success or a negative error code.
+func (w *Window) SetModalFor(parent *Window) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_SetWindowModalFor(w.cWindow, parent.cWindow))
+}
+
+// Explicitly gives the window input focus, without raising it.
+// Use with caution, as this might give focus to a window that's
+// completely obscured by other windows.
+// Returns 0 on success or a negative error code.
+func (w *Window) SetInputFocus() int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_SetWindowInputFocus(w.cWindow))
+}
+
+// Requests the user's attention for the window, eg. by flashing its
+// taskbar entry or bouncing its dock icon. The operation is one of the
+// FLASH_* constants. Returns 0 on success or a negative error code.
+func (w *Window) Flash(operation int) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_FlashWindow(w.cWindow, C.SDL_FlashOperation(operation)))
+}
+
+// Gets the window that currently has an input grab enabled, or nil if
+// no window has grabbed input.
+func GetGrabbedWindow() *Window {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return wrapWindow(C.SDL_GetGrabbedWindow())
+}
+
+func (w *Window) Destroy() {
+	w.removeHitTest()
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_DestroyWindow(w.cWindow)
+}
+
+func (w *Window) ShowSimpleMessageBox(flags uint32, title, message string) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	ctitle, cmessage := C.CString(title), C.CString(message)
+	C.SDL_ShowSimpleMessageBox(C.Uint32(flags), ctitle, cmessage, w.cWindow)
+
+	C.free(unsafe.Pointer(ctitle))
+	C.free(unsafe.Pointer(cmessage))
+}
+
+// A button shown in a message box.
+type MessageBoxButton struct {
+	Flags    uint32 // MESSAGEBOX_BUTTON_RETURNKEY_DEFAULT and/or MESSAGEBOX_BUTTON_ESCAPEKEY_DEFAULT
+	ButtonID int    // Returned by ShowMessageBox when the button is pressed
+	Text     string
+}
+
+// Colors for the parts of a message box, indexed by the MESSAGEBOX_COLOR_*
+// constants. The Alpha field of the colors is ignored.
+type MessageBoxColorScheme struct {
+	Colors [MESSAGEBOX_COLOR_MAX]Color
+}
+
+// Describes a message box for ShowMessageBox.
+type MessageBoxData struct {
+	Flags       uint32 // MESSAGEBOX_ERROR, MESSAGEBOX_WARNING or MESSAGEBOX_INFORMATION
+	Window      *Window
+	Title       string
+	Message     string
+	Buttons     []MessageBoxButton
+	ColorScheme *MessageBoxColorScheme // nil for the system defaults
+}
+
+// Shows a modal message box and blocks until the user presses one of its
+// buttons. Returns the ButtonID of the pressed button, or -1 if the box
+// was closed without pressing a button.
+func ShowMessageBox(data *MessageBoxData) (int, error) {
+	defer lockThread()()
+
+	var cdata C.SDL_MessageBoxData
+
+	cdata.flags = C.Uint32(data.Flags)
+	if data.Window != nil {
+		cdata.window = data.Window.cWindow
+	}
+
+	cdata.title = C.CString(data.Title)
+	defer C.free(unsafe.Pointer(cdata.title))
+	cdata.message = C.CString(data.Message)
+	defer C.free(unsafe.Pointer(cdata.message))
+
+	// The button and color arrays are referenced from cdata, so they have
+	// to live in C memory
+	if n := len(data.Buttons); n > 0 {
+		cbuttons := (*C.SDL_MessageBoxButtonData)(C.malloc(C.size_t(n) * C.sizeof_SDL_MessageBoxButtonData))
+		defer C.free(unsafe.Pointer(cbuttons))
+
+		buttons := unsafe.Slice(cbuttons, n)
+		for i, b := range data.Buttons {
+			buttons[i].flags = C.Uint32(b.Flags)
+			buttons[i].buttonid = C.int(b.ButtonID)
+			buttons[i].text = C.CString(b.Text)
+			defer C.free(unsafe.Pointer(buttons[i].text))
+		}
+
+		cdata.numbuttons = C.int(n)
+		cdata.buttons = cbuttons
+	}
+
+	if data.ColorScheme != nil {
+		cscheme := (*C.SDL_MessageBoxColorScheme)(C.malloc(C.sizeof_SDL_MessageBoxColorScheme))
+		defer C.free(unsafe.Pointer(cscheme))
+
+		for i, c := range data.ColorScheme.Colors {
+			cscheme.colors[i] = C.SDL_MessageBoxColor{C.Uint8(c.R), C.Uint8(c.G), C.Uint8(c.B)}
+		}
+
+		cdata.colorScheme = cscheme
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var buttonid C.int
+	if C.SDL_ShowMessageBox(&cdata, &buttonid) != 0 {
+		return -1, sdlError()
+	}
+
+	return int(buttonid), nil
+}
+
+// ======
+// Video
+// ======
+
+var currentVideoSurface *Surface = nil
+
+// Returns a pointer to the current display surface.
+func GetVideoSurface() *Surface {
+	GlobalMutex.Lock()
+	surface := currentVideoSurface
+	GlobalMutex.Unlock()
+	return surface
+}
+
+// Gets the surface associated with the window, for software rendering
+// without a Renderer. The surface is owned by the window: it becomes invalid
+// when the window is resized or destroyed, and the returned surface is what
+// GetVideoSurface reports until then. Returns nil on error.
+func (w *Window) GetSurface() *Surface {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	cSurface := C.SDL_GetWindowSurface(w.cWindow)
+
+	if currentVideoSurface != nil && currentVideoSurface.cSurface == cSurface {
+		currentVideoSurface.reload()
+		return currentVideoSurface
+	}
+
+	currentVideoSurface = wrapSurface(cSurface)
+	return currentVideoSurface
+}
+
+// Copies the window surface to the screen.
+// Returns 0 on success or a negative error code.
+func (w *Window) UpdateSurface() int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_UpdateWindowSurface(w.cWindow))
+}
+
+// Copies areas of the window surface to the screen.
+// Returns 0 on success or a negative error code.
+func (w *Window) UpdateSurfaceRects(rects []Rect) int {
+	if len(rects) == 0 {
+		return 0
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_UpdateWindowSurfaceRects(w.cWindow,
+		(*C.SDL_Rect)(cast(&rects[0])), C.int(len(rects))))
+}
+
+// Gets the size of the window's OpenGL drawable in pixels, for use with
+// glViewport. On high-DPI displays this is larger than the window size in
+// screen coordinates if the window was created with WINDOW_ALLOW_HIGHDPI.
+func (w *Window) GL_GetDrawableSize() (int, int) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var width, height C.int
+	C.SDL_GL_GetDrawableSize(w.cWindow, &width, &height)
+
+	return int(width), int(height)
+}
+
+// Swaps OpenGL framebuffers/Update Display.
+func (w *Window) GL_SwapWindow() {
+	GlobalMutex.Lock()
+	C.SDL_GL_SwapWindow(w.cWindow)
+	GlobalMutex.Unlock()
+}
+
+// An OpenGL context.
+type GLContext struct {
+	cContext C.SDL_GLContext
+}
+
+// Creates an OpenGL context for a window created with WINDOW_OPENGL and
+// makes it current. Contexts are current on an OS thread, not on a
+// goroutine: the goroutine using the context must call
+// runtime.LockOSThread first.
+func (w *Window) GL_CreateContext() (*GLContext, error) {
+	defer lockThread()()
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	context := C.SDL_GL_CreateContext(w.cWindow)
+	if context == nil {
+		return nil, sdlError()
+	}
+
+	return &GLContext{context}, nil
+}
+
+// Makes the context current on the calling OS thread, rendering to the
+// window. A nil context releases the current one.
+// Returns 0 on success or a negative error code.
+func (w *Window) GL_MakeCurrent(context *GLContext) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var c C.SDL_GLContext
+	if context != nil {
+		c = context.cContext
+	}
+
+	return int(C.SDL_GL_MakeCurrent(w.cWindow, c))
+}
+
+// Deletes an OpenGL context.
+func GL_DeleteContext(context *GLContext) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_GL_DeleteContext(context.cContext)
+	context.cContext = nil
+}
+
+// Gets the context current on the calling OS thread, or nil.
+func GL_GetCurrentContext() *GLContext {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	context := C.SDL_GL_GetCurrentContext()
+	if context == nil {
+		return nil
+	}
+
+	return &GLContext{context}
+}
+
+// Gets the window the current context renders to, or nil.
+func GL_GetCurrentWindow() *Window {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return wrapWindow(C.SDL_GL_GetCurrentWindow())
+}
+
+// Gets the address of an OpenGL function, or nil if it isn't available,
+// for OpenGL loaders such as go-gl's InitWithProcAddrFunc. An OpenGL
+// context must be current; the addresses may differ between contexts on
+// some platforms.
+func GL_GetProcAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_GL_GetProcAddress(cname)
+}
+
+// Loads an OpenGL library, such as ANGLE or a software Mesa build, instead
+// of the system's default. Must be called after Init and before creating
+// any OpenGL window; an empty path loads the default library.
+// Returns 0 on success or a negative error code.
+func GL_LoadLibrary(path string) int {
+	var cpath *C.char
+	if path != "" {
+		cpath = C.CString(path)
+		defer C.free(unsafe.Pointer(cpath))
+	}
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_GL_LoadLibrary(cpath))
+}
+
+// Unloads the OpenGL library loaded with GL_LoadLibrary.
+func GL_UnloadLibrary() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_GL_UnloadLibrary()
+}
+
+func GL_SetAttribute(attr int, value int) int {
+	GlobalMutex.Lock()
+	status := int(C.SDL_GL_SetAttribute(C.SDL_GLattr(attr), C.int(value)))
+	GlobalMutex.Unlock()
+	return status
+}
+
+// Gets the actual value of a GL_* attribute for the current OpenGL
+// context, which may differ from the requested value.
+func GL_GetAttribute(attr int) (int, error) {
+	defer lockThread()()
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var value C.int
+	if C.SDL_GL_GetAttribute(C.SDL_GLattr(attr), &value) != 0 {
+		return 0, sdlError()
+	}
 
-	C.free(unsafe.Pointer(ctitle))
+	return int(value), nil
 }
 
-func (w *Window) SetIcon(s *Surface) {
+// Sets the swap interval of the current OpenGL context: 0 for immediate
+// buffer swaps, 1 to wait for the vertical retrace, or -1 for adaptive
+// vertical sync (see GL_SetAdaptiveSync).
+// Returns 0 on success or -1 if the interval isn't supported.
+func GL_SetSwapInterval(interval int) int {
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
-	C.SDL_SetWindowIcon(w.cWindow, s.cSurface)
+	return int(C.SDL_GL_SetSwapInterval(C.int(interval)))
 }
 
-func (w *Window) SetFullscreen(flags uint32) {
+// Gets the swap interval of the current OpenGL context.
+func GL_GetSwapInterval() int {
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
-	C.SDL_SetWindowFullscreen(w.cWindow, C.Uint32(flags))
+	return int(C.SDL_GL_GetSwapInterval())
 }
 
-func (w *Window) Destroy() {
+// Requests adaptive vertical sync for the current OpenGL context: buffer
+// swaps wait for the vertical retrace, unless the frame is late, in which
+// case it is shown immediately. On variable refresh rate (G-Sync/FreeSync)
+// monitors this avoids the stutter of missed retraces. If the driver
+// doesn't support adaptive sync, regular vertical sync is used instead.
+// Returns true if adaptive sync is in effect.
+func GL_SetAdaptiveSync() (bool, error) {
+	defer lockThread()()
+
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
-	C.SDL_DestroyWindow(w.cWindow)
+	if C.SDL_GL_SetSwapInterval(-1) == 0 {
+		return true, nil
+	}
+
+	if C.SDL_GL_SetSwapInterval(1) != 0 {
+		return false, sdlError()
+	}
+
+	return false, nil
 }
 
-func (w *Window) ShowSimpleMessageBox(flags uint32, title, message string) {
+// Checks whether adaptive vertical sync is in effect for the current
+// OpenGL context.
+func GL_AdaptiveSyncActive() bool {
 	GlobalMutex.Lock()
 	defer GlobalMutex.Unlock()
 
-	ctitle, cmessage := C.CString(title), C.CString(message)
-	C.SDL_ShowSimpleMessageBox(C.Uint32(flags), ctitle, cmessage, w.cWindow)
-
-	C.free(unsafe.Pointer(ctitle))
-	C.free(unsafe.Pointer(cmessage))
+	return C.SDL_GL_GetSwapInterval() == -1
 }
 
-// ======
-// Video
-// ======
+// Binds the texture to the current OpenGL context, for sampling it from
+// your own OpenGL code, and returns the texture coordinates of its bottom
+// right corner (these are below 1 if the texture was padded to a power of
+// two). Only works with the OpenGL based renderers.
+func (t *Texture) GL_BindTexture() (texw, texh float32, err error) {
+	defer lockThread()()
 
-var currentVideoSurface *Surface = nil
+	if err := t.tryAcquire(); err != nil {
+		return 0, 0, err
+	}
+	defer t.mutex.Unlock()
 
-// Returns a pointer to the current display surface.
-func GetVideoSurface() *Surface {
-	GlobalMutex.Lock()
-	surface := currentVideoSurface
-	GlobalMutex.Unlock()
-	return surface
-}
+	var w, h C.float
+	if C.SDL_GL_BindTexture(t.cTexture, &w, &h) != 0 {
+		return 0, 0, sdlError()
+	}
 
-// Swaps OpenGL framebuffers/Update Display.
-func (w *Window) GL_SwapWindow() {
-	GlobalMutex.Lock()
-	C.SDL_GL_SwapWindow(w.cWindow)
-	GlobalMutex.Unlock()
+	return float32(w), float32(h), nil
 }
 
-func (w *Window) GL_CreateContext() {
-	GlobalMutex.Lock()
-	C.SDL_GL_CreateContext(w.cWindow)
-	GlobalMutex.Unlock()
-}
+// Unbinds a texture bound with GL_BindTexture.
+// Returns 0 on success or a negative error code.
+func (t *Texture) GL_UnbindTexture() int {
+	t.acquire()
+	defer t.mutex.Unlock()
 
-func GL_SetAttribute(attr int, value int) int {
-	GlobalMutex.Lock()
-	status := int(C.SDL_GL_SetAttribute(C.SDL_GLattr(attr), C.int(value)))
-	GlobalMutex.Unlock()
-	return status
+	return int(C.SDL_GL_UnbindTexture(t.cTexture))
 }
 
 // Frees (deletes) a Surface
@@ -503,22 +2058,98 @@ func (screen *Surface) Free() {
 
 // Locks a surface for direct access.
 func (screen *Surface) Lock() int {
+	screen.check()
 	screen.mutex.Lock()
-	status := int(C.SDL_LockSurface(screen.cSurface))
-	screen.mutex.Unlock()
-	return status
+	defer screen.mutex.Unlock()
+
+	return screen.lock()
 }
 
 // Unlocks a previously locked surface.
 func (screen *Surface) Unlock() {
+	screen.check()
 	screen.mutex.Lock()
-	C.SDL_UnlockSurface(screen.cSurface)
-	screen.mutex.Unlock()
+	defer screen.mutex.Unlock()
+
+	screen.unlock()
+}
+
+// Locks the surface for callers already holding s.mutex exclusively.
+func (s *Surface) lock() int {
+	status := int(C.SDL_LockSurface(s.cSurface))
+	// Locking an RLE surface decodes its pixels into new memory
+	s.reload()
+	return status
+}
+
+// Unlocks the surface for callers already holding s.mutex exclusively.
+func (s *Surface) unlock() {
+	C.SDL_UnlockSurface(s.cSurface)
+	s.reload()
 }
 
 // Performs a fast blit from the source surface to the destination surface.
 // This is the same as func BlitSurface, but the order of arguments is reversed.
 func (dst *Surface) Blit(dstrect *Rect, src *Surface, srcrect *Rect) int {
+	return dst.blit(blitUpper, dstrect, src, srcrect)
+}
+
+// Performs a scaled blit from the source surface to the destination
+// surface, stretching srcrect (the whole source if nil) to fill dstrect
+// (the whole destination if nil).
+func (dst *Surface) BlitScaled(dstrect *Rect, src *Surface, srcrect *Rect) int {
+	return dst.blit(blitUpperScaled, dstrect, src, srcrect)
+}
+
+// Performs a blit without checking or clipping the rects, for callers that
+// already did. Both rects must be given and lie within their surfaces.
+func (dst *Surface) LowerBlit(dstrect *Rect, src *Surface, srcrect *Rect) int {
+	if dstrect == nil || srcrect == nil {
+		return -1
+	}
+	return dst.blit(blitLower, dstrect, src, srcrect)
+}
+
+// Performs a scaled blit without checking or clipping the rects, for
+// callers that already did. Both rects must be given and lie within their
+// surfaces.
+func (dst *Surface) LowerBlitScaled(dstrect *Rect, src *Surface, srcrect *Rect) int {
+	if dstrect == nil || srcrect == nil {
+		return -1
+	}
+	return dst.blit(blitLowerScaled, dstrect, src, srcrect)
+}
+
+// Copies srcrect of the source surface (the whole surface if nil) to
+// dstrect of the destination (the whole surface if nil), scaling it with
+// nearest neighbor sampling. Unlike BlitScaled no blending or format
+// conversion is done, both surfaces must have the same format.
+// Returns 0 on success or a negative error code.
+func (dst *Surface) SoftStretch(dstrect *Rect, src *Surface, srcrect *Rect) int {
+	return dst.blit(blitStretch, dstrect, src, srcrect)
+}
+
+// Works like SoftStretch, but with bilinear filtering, which gives smoother
+// results when shrinking images such as thumbnails. Only 32-bit formats
+// are supported.
+func (dst *Surface) SoftStretchLinear(dstrect *Rect, src *Surface, srcrect *Rect) int {
+	return dst.blit(blitStretchLinear, dstrect, src, srcrect)
+}
+
+// Kinds of blits done by Surface.blit
+const (
+	blitUpper = iota
+	blitUpperScaled
+	blitLower
+	blitLowerScaled
+	blitStretch
+	blitStretchLinear
+)
+
+func (dst *Surface) blit(kind int, dstrect *Rect, src *Surface, srcrect *Rect) int {
+	dst.check()
+	src.check()
+
 	GlobalMutex.Lock()
 	global := true
 	if (src != currentVideoSurface) && (dst != currentVideoSurface) {
@@ -534,11 +2165,23 @@ func (dst *Surface) Blit(dstrect *Rect, src *Surface, srcrect *Rect) int {
 		src.mutex.RLock()
 		dst.mutex.Lock()
 
-		ret = C.SDL_UpperBlit(
-			src.cSurface,
-			(*C.SDL_Rect)(cast(srcrect)),
-			dst.cSurface,
-			(*C.SDL_Rect)(cast(dstrect)))
+		csrcrect := (*C.SDL_Rect)(cast(srcrect))
+		cdstrect := (*C.SDL_Rect)(cast(dstrect))
+
+		switch kind {
+		case blitUpper:
+			ret = C.SDL_UpperBlit(src.cSurface, csrcrect, dst.cSurface, cdstrect)
+		case blitUpperScaled:
+			ret = C.SDL_UpperBlitScaled(src.cSurface, csrcrect, dst.cSurface, cdstrect)
+		case blitLower:
+			ret = C.SDL_LowerBlit(src.cSurface, csrcrect, dst.cSurface, cdstrect)
+		case blitLowerScaled:
+			ret = C.SDL_LowerBlitScaled(src.cSurface, csrcrect, dst.cSurface, cdstrect)
+		case blitStretch:
+			ret = C.SDL_SoftStretch(src.cSurface, csrcrect, dst.cSurface, cdstrect)
+		case blitStretchLinear:
+			ret = C.SDL_SoftStretchLinear(src.cSurface, csrcrect, dst.cSurface, cdstrect)
+		}
 
 		dst.mutex.Unlock()
 		src.mutex.RUnlock()
@@ -556,8 +2199,14 @@ func BlitSurface(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) int {
 	return dst.Blit(dstrect, src, srcrect)
 }
 
+// Performs a scaled blit from the source surface to the destination surface.
+func BlitScaled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) int {
+	return dst.BlitScaled(dstrect, src, srcrect)
+}
+
 // This function performs a fast fill of the given rectangle with some color.
 func (dst *Surface) FillRect(dstrect *Rect, color uint32) int {
+	dst.check()
 	dst.mutex.Lock()
 
 	var ret = C.SDL_FillRect(
@@ -570,17 +2219,89 @@ func (dst *Surface) FillRect(dstrect *Rect, color uint32) int {
 	return int(ret)
 }
 
+// Fills several rectangles with a color in a single call.
+// Returns 0 on success or a negative error code.
+func (dst *Surface) FillRects(dstrects []Rect, color uint32) int {
+	if len(dstrects) == 0 {
+		return 0
+	}
+
+	dst.check()
+	dst.mutex.Lock()
+
+	var ret = C.SDL_FillRects(
+		dst.cSurface,
+		(*C.SDL_Rect)(cast(&dstrects[0])),
+		C.int(len(dstrects)),
+		C.Uint32(color))
+
+	dst.mutex.Unlock()
+
+	return int(ret)
+}
+
 // Sets the color key (transparent pixel)  in  a  blittable  surface  and
 // enables or disables RLE blit acceleration.
 func (s *Surface) SetColorKey(flags uint32, ColorKey uint32) int {
+	s.check()
 	s.mutex.Lock()
 	status := int(C.SDL_SetColorKey(s.cSurface, C.int(flags), C.Uint32(ColorKey)))
 	s.mutex.Unlock()
 	return status
 }
 
+// Checks whether the surface has a color key.
+func (s *Surface) HasColorKey() bool {
+	s.check()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return C.SDL_HasColorKey(s.cSurface) == C.SDL_TRUE
+}
+
+// Gets the color key (transparent pixel value) of the surface. ok is false
+// if the surface has no color key.
+func (s *Surface) GetColorKey() (key uint32, ok bool) {
+	s.check()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var ckey C.Uint32
+	if C.SDL_GetColorKey(s.cSurface, &ckey) != 0 {
+		return 0, false
+	}
+
+	return uint32(ckey), true
+}
+
+// Turns RLE acceleration on or off. RLE speeds up blitting surfaces with
+// a color key or alpha, but the surface must be locked to access its
+// pixels. Returns 0 on success or a negative error code.
+func (s *Surface) SetRLE(enable bool) int {
+	s.check()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	flag := 0
+	if enable {
+		flag = 1
+	}
+
+	return int(C.SDL_SetSurfaceRLE(s.cSurface, C.int(flag)))
+}
+
+// Checks whether the surface is RLE enabled.
+func (s *Surface) HasRLE() bool {
+	s.check()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return C.SDL_HasSurfaceRLE(s.cSurface) == C.SDL_TRUE
+}
+
 // Gets the clipping rectangle for a surface.
 func (s *Surface) GetClipRect(r *Rect) {
+	s.check()
 	s.mutex.RLock()
 	C.SDL_GetClipRect(s.cSurface, (*C.SDL_Rect)(cast(r)))
 	s.mutex.RUnlock()
@@ -588,21 +2309,105 @@ func (s *Surface) GetClipRect(r *Rect) {
 
 // Sets the clipping rectangle for a surface.
 func (s *Surface) SetClipRect(r *Rect) {
+	s.check()
 	s.mutex.Lock()
 	C.SDL_SetClipRect(s.cSurface, (*C.SDL_Rect)(cast(r)))
 	s.mutex.Unlock()
 }
 
+// Sets an additional alpha value multiplied into blit operations.
+// Returns 0 on success or a negative error code.
+func (s *Surface) SetAlphaMod(alpha uint8) int {
+	s.check()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return int(C.SDL_SetSurfaceAlphaMod(s.cSurface, C.Uint8(alpha)))
+}
+
+// Gets the additional alpha value multiplied into blit operations.
+func (s *Surface) GetAlphaMod() uint8 {
+	s.check()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var alpha C.Uint8
+	C.SDL_GetSurfaceAlphaMod(s.cSurface, &alpha)
+
+	return uint8(alpha)
+}
+
+// Sets an additional color value multiplied into blit operations, for
+// tinting. Returns 0 on success or a negative error code.
+func (s *Surface) SetColorMod(r, g, b uint8) int {
+	s.check()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return int(C.SDL_SetSurfaceColorMod(s.cSurface, C.Uint8(r), C.Uint8(g), C.Uint8(b)))
+}
+
+// Gets the additional color value multiplied into blit operations.
+func (s *Surface) GetColorMod() (r, g, b uint8) {
+	s.check()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var cr, cg, cb C.Uint8
+	C.SDL_GetSurfaceColorMod(s.cSurface, &cr, &cg, &cb)
+
+	return uint8(cr), uint8(cg), uint8(cb)
+}
+
+// Sets how the surface is blended when it is the source of a blit, one of
+// the BLENDMODE_* constants. Returns 0 on success or a negative error code.
+func (s *Surface) SetBlendMode(mode int) int {
+	s.check()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return int(C.SDL_SetSurfaceBlendMode(s.cSurface, C.SDL_BlendMode(mode)))
+}
+
+// Gets how the surface is blended when it is the source of a blit.
+func (s *Surface) GetBlendMode() int {
+	s.check()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var mode C.SDL_BlendMode
+	C.SDL_GetSurfaceBlendMode(s.cSurface, &mode)
+
+	return int(mode)
+}
+
 // Map a RGBA color value to a pixel format.
 func MapRGBA(format *PixelFormat, r, g, b, a uint8) uint32 {
 	return (uint32)(C.SDL_MapRGBA((*C.SDL_PixelFormat)(cast(format)), (C.Uint8)(r), (C.Uint8)(g), (C.Uint8)(b), (C.Uint8)(a)))
 }
 
+// Map a RGB color value to a pixel format. Formats with alpha get an
+// opaque pixel.
+func MapRGB(format *PixelFormat, r, g, b uint8) uint32 {
+	return (uint32)(C.SDL_MapRGB((*C.SDL_PixelFormat)(cast(format)), (C.Uint8)(r), (C.Uint8)(g), (C.Uint8)(b)))
+}
+
 // Gets RGBA values from a pixel in the specified pixel format.
 func GetRGBA(color uint32, format *PixelFormat, r, g, b, a *uint8) {
 	C.SDL_GetRGBA(C.Uint32(color), (*C.SDL_PixelFormat)(cast(format)), (*C.Uint8)(r), (*C.Uint8)(g), (*C.Uint8)(b), (*C.Uint8)(a))
 }
 
+// Gets RGB values from a pixel in the specified pixel format.
+func GetRGB(color uint32, format *PixelFormat, r, g, b *uint8) {
+	C.SDL_GetRGB(C.Uint32(color), (*C.SDL_PixelFormat)(cast(format)), (*C.Uint8)(r), (*C.Uint8)(g), (*C.Uint8)(b))
+}
+
+// Map a color of any type to a pixel format.
+func MapColor(format *PixelFormat, c color.Color) uint32 {
+	n := ColorModel.Convert(c).(Color)
+	return MapRGBA(format, n.R, n.G, n.B, n.Alpha)
+}
+
 // Loads Surface from file (using IMG_Load).
 func Load(file string) *Surface {
 	GlobalMutex.Lock()
@@ -616,6 +2421,62 @@ func Load(file string) *Surface {
 	return wrapSurface(screen)
 }
 
+// Loads an image file straight into a texture for the renderer (using
+// IMG_LoadTexture), without going through a Surface.
+func LoadTexture(r *Renderer, file string) (*Texture, error) {
+	defer lockThread()()
+
+	cfile := C.CString(file)
+	defer C.free(unsafe.Pointer(cfile))
+
+	if err := r.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer r.mutex.Unlock()
+
+	texture := C.IMG_LoadTexture(r.cRenderer, cfile)
+	if texture == nil {
+		return nil, sdlError()
+	}
+
+	return wrapTexture(texture, r.shared), nil
+}
+
+// Decodes an image held in memory into a texture for the renderer (using
+// IMG_LoadTextureTyped_RW). typ is a hint such as "PNG" or "JPG" for
+// formats that cannot be detected reliably, or "" to detect the format.
+func LoadTextureFromMemory(r *Renderer, data []byte, typ string) (*Texture, error) {
+	defer lockThread()()
+
+	if len(data) == 0 {
+		return nil, errors.New("sdl: no image data")
+	}
+
+	var ctype *C.char
+	if typ != "" {
+		ctype = C.CString(typ)
+		defer C.free(unsafe.Pointer(ctype))
+	}
+
+	if err := r.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer r.mutex.Unlock()
+
+	rw := C.SDL_RWFromConstMem(unsafe.Pointer(&data[0]), C.int(len(data)))
+	if rw == nil {
+		return nil, sdlError()
+	}
+
+	// Closes rw
+	texture := C.IMG_LoadTextureTyped_RW(r.cRenderer, rw, 1, ctype)
+	if texture == nil {
+		return nil, sdlError()
+	}
+
+	return wrapTexture(texture, r.shared), nil
+}
+
 // Creates an empty Surface.
 func CreateRGBSurface(flags uint32, width int, height int, bpp int, Rmask uint32, Gmask uint32, Bmask uint32, Amask uint32) *Surface {
 	GlobalMutex.Lock()
@@ -644,16 +2505,90 @@ func CreateRGBSurfaceFrom(pixels interface{}, width, height, bpp, pitch int, Rma
 	GlobalMutex.Unlock()
 
 	s := wrapSurface(p)
-	s.gcPixels = pixels
+	if s != nil {
+		s.gcPixels = pixels
+	}
+	return s
+}
+
+// Creates an empty Surface in the format given by a PIXELFORMAT_* value,
+// without having to work out the channel masks. depth is ignored since
+// SDL 2.0.10, it is implied by the format.
+func CreateRGBSurfaceWithFormat(flags uint32, width, height, depth int, format uint32) *Surface {
+	GlobalMutex.Lock()
+	p := C.SDL_CreateRGBSurfaceWithFormat(C.Uint32(flags), C.int(width), C.int(height),
+		C.int(depth), C.Uint32(format))
+	GlobalMutex.Unlock()
+
+	return wrapSurface(p)
+}
+
+// Creates a Surface using existing pixel data in the format given by a
+// PIXELFORMAT_* value. pixels is kept alive as by CreateRGBSurfaceFrom.
+func CreateRGBSurfaceWithFormatFrom(pixels interface{}, width, height, depth, pitch int, format uint32) *Surface {
+	var ptr unsafe.Pointer
+	switch v := reflect.ValueOf(pixels); v.Kind() {
+	case reflect.Ptr, reflect.UnsafePointer, reflect.Slice:
+		ptr = unsafe.Pointer(v.Pointer())
+	default:
+		panic("Don't know how to handle type: " + v.Kind().String())
+	}
+
+	GlobalMutex.Lock()
+	p := C.SDL_CreateRGBSurfaceWithFormatFrom(ptr, C.int(width), C.int(height), C.int(depth),
+		C.int(pitch), C.Uint32(format))
+	GlobalMutex.Unlock()
+
+	s := wrapSurface(p)
+	if s != nil {
+		s.gcPixels = pixels
+	}
 	return s
 }
 
+// Creates a copy of the surface in another pixel format, such as one
+// returned by AllocFormat or the Format of another surface.
+// Returns nil on error.
+func (s *Surface) Convert(format *PixelFormat) *Surface {
+	s.check()
+	s.mutex.RLock()
+	p := C.SDL_ConvertSurface(s.cSurface, (*C.SDL_PixelFormat)(cast(format)), 0)
+	s.mutex.RUnlock()
+
+	return wrapSurface(p)
+}
+
+// Creates a copy of the surface in the pixel format given by a
+// PIXELFORMAT_* value. Returns nil on error.
+func (s *Surface) ConvertFormat(format uint32) *Surface {
+	s.check()
+	s.mutex.RLock()
+	p := C.SDL_ConvertSurfaceFormat(s.cSurface, C.Uint32(format), 0)
+	s.mutex.RUnlock()
+
+	return wrapSurface(p)
+}
+
+// Creates a copy of the surface, with its own pixel memory.
+// Returns nil on error.
+func (s *Surface) Duplicate() *Surface {
+	s.check()
+	s.mutex.RLock()
+	p := C.SDL_DuplicateSurface(s.cSurface)
+	s.mutex.RUnlock()
+
+	return wrapSurface(p)
+}
+
 // Modifier
 type Mod C.int
 
 // Key
 type Key C.int
 
+// Scancode, the physical location of a key on the keyboard
+type Scancode C.int
+
 // Gets the state of modifier keys
 func GetModState() Mod {
 	GlobalMutex.Lock()
@@ -669,6 +2604,12 @@ func SetModState(modstate Mod) {
 	GlobalMutex.Unlock()
 }
 
+// Reports whether any of the KMOD_* flags in mods is set, so that
+// Has(KMOD_CTRL) holds for either Ctrl key
+func (m Mod) Has(mods Mod) bool {
+	return m&mods != 0
+}
+
 // Gets the name of an SDL virtual keysym
 func GetKeyName(key Key) string {
 	GlobalMutex.Lock()
@@ -677,6 +2618,68 @@ func GetKeyName(key Key) string {
 	return name
 }
 
+// Gets a key from a human-readable name, or K_UNKNOWN if the name is not
+// recognized
+func GetKeyFromName(name string) Key {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	GlobalMutex.Lock()
+	key := Key(C.SDL_GetKeyFromName(cname))
+	GlobalMutex.Unlock()
+	return key
+}
+
+// Gets the key produced by a physical key under the current keyboard layout
+func GetKeyFromScancode(scancode Scancode) Key {
+	GlobalMutex.Lock()
+	key := Key(C.SDL_GetKeyFromScancode(C.SDL_Scancode(scancode)))
+	GlobalMutex.Unlock()
+	return key
+}
+
+// Gets the physical key producing a key under the current keyboard layout
+func GetScancodeFromKey(key Key) Scancode {
+	GlobalMutex.Lock()
+	scancode := Scancode(C.SDL_GetScancodeFromKey(C.SDL_Keycode(key)))
+	GlobalMutex.Unlock()
+	return scancode
+}
+
+// Gets the name of a scancode, or an empty string if it has none
+func GetScancodeName(scancode Scancode) string {
+	GlobalMutex.Lock()
+	name := C.GoString(C.SDL_GetScancodeName(C.SDL_Scancode(scancode)))
+	GlobalMutex.Unlock()
+	return name
+}
+
+// Gets a scancode from a human-readable name, or SCANCODE_UNKNOWN if the
+// name is not recognized
+func GetScancodeFromName(name string) Scancode {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	GlobalMutex.Lock()
+	scancode := Scancode(C.SDL_GetScancodeFromName(cname))
+	GlobalMutex.Unlock()
+	return scancode
+}
+
+// Gets the current state of the keyboard, indexed by scancode: a non-zero
+// entry means the key is held down. The slice is a live view of SDL's own
+// state, updated as events are processed, so it can be obtained once and
+// checked every frame. It must not be modified.
+func GetKeyboardState() []uint8 {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var numkeys C.int
+	state := C.SDL_GetKeyboardState(&numkeys)
+
+	return unsafe.Slice((*uint8)(state), int(numkeys))
+}
+
 // ======
 // Events
 // ======
@@ -692,6 +2695,32 @@ func (event *Event) poll() bool {
 	return ret != 0
 }
 
+// Adds an event to the event queue, from where it is delivered through
+// sdl.Events like events coming from the system. event is a pointer to
+// one of the event types delivered by sdl.Events (or to an Event) with
+// the Type field set. Returns 1 on success, 0 if the event was filtered,
+// or a negative error code, also returned if event is not a non-nil
+// pointer.
+func PushEvent(event interface{}) int {
+	var e Event
+
+	v := reflect.ValueOf(event)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return -1
+	}
+
+	size := v.Type().Elem().Size()
+	if size > unsafe.Sizeof(e) {
+		return -1
+	}
+	copy((*[unsafe.Sizeof(e)]byte)(cast(&e))[:], unsafe.Slice((*byte)(ptr(event)), size))
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return int(C.SDL_PushEvent((*C.SDL_Event)(cast(&e))))
+}
+
 // =====
 // Mouse
 // =====
@@ -834,6 +2863,23 @@ func (joystick *Joystick) GetAxis(axis int) int16 {
 	return int16(C.SDL_JoystickGetAxis(joystick.cJoystick, C.int(axis)))
 }
 
+// =====
+// Power
+// =====
+
+// Gets the current power supply details. The returned state is one of the
+// POWERSTATE_* constants; secs and pct are the seconds of battery life and
+// the battery percentage left, or -1 if they cannot be determined.
+func GetPowerInfo() (state, secs, pct int) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var csecs, cpct C.int
+	cstate := C.SDL_GetPowerInfo(&csecs, &cpct)
+
+	return int(cstate), int(csecs), int(cpct)
+}
+
 // ====
 // Time
 // ====
@@ -0,0 +1,73 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+//
+// static int setWindowShape(SDL_Window *window, SDL_Surface *shape, int mode, Uint8 cutoff, Uint8 r, Uint8 g, Uint8 b, Uint8 a) {
+//	SDL_WindowShapeMode shapeMode;
+//	shapeMode.mode = (WindowShapeMode)mode;
+//	if (mode == ShapeModeColorKey) {
+//		SDL_Color key = {r, g, b, a};
+//		shapeMode.parameters.colorKey = key;
+//	} else {
+//		shapeMode.parameters.binarizationCutoff = cutoff;
+//	}
+//	return SDL_SetWindowShape(window, shape, &shapeMode);
+// }
+import "C"
+
+import "unsafe"
+
+// =============
+// Shaped window
+// =============
+
+// Describes how the shape of a window is derived from a surface.
+type WindowShapeMode struct {
+	Mode int // One of the SHAPEMODE_* constants
+
+	// For SHAPEMODE_BINARIZE_ALPHA and SHAPEMODE_REVERSE_BINARIZE_ALPHA:
+	// the alpha value at which pixels become part of the window.
+	BinarizationCutoff uint8
+
+	// For SHAPEMODE_COLOR_KEY: pixels of this color are left out of the window.
+	ColorKey Color
+}
+
+// Creates a window that can be given a non-rectangular shape with SetShape.
+// The position arguments are ignored by SDL. Returns nil on error.
+func CreateShapedWindow(title string, x, y, w, h int, flags uint32) *Window {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+
+	window := C.SDL_CreateShapedWindow(ctitle, C.uint(x), C.uint(y),
+		C.uint(w), C.uint(h), C.Uint32(flags))
+
+	return wrapWindow(window)
+}
+
+// Checks whether the window was created with CreateShapedWindow.
+func (w *Window) IsShaped() bool {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_IsShapedWindow(w.cWindow) == C.SDL_TRUE
+}
+
+// Sets the shape of a shaped window from a surface of the same size as
+// the window. Returns 0 on success, or one of the negative SDL shape
+// error codes.
+func (w *Window) SetShape(shape *Surface, mode *WindowShapeMode) int {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	shape.mutex.RLock()
+	defer shape.mutex.RUnlock()
+
+	key := mode.ColorKey
+	return int(C.setWindowShape(w.cWindow, shape.cSurface, C.int(mode.Mode),
+		C.Uint8(mode.BinarizationCutoff),
+		C.Uint8(key.R), C.Uint8(key.G), C.Uint8(key.B), C.Uint8(key.Alpha)))
+}
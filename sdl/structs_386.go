@@ -1,9 +1,15 @@
 package sdl
 
 type PixelFormat struct {
+	Format        uint32
 	Palette       *Palette
 	BitsPerPixel  uint8
 	BytesPerPixel uint8
+	Pad0          [2]byte
+	Rmask         uint32
+	Gmask         uint32
+	Bmask         uint32
+	Amask         uint32
 	Rloss         uint8
 	Gloss         uint8
 	Bloss         uint8
@@ -12,34 +18,54 @@ type PixelFormat struct {
 	Gshift        uint8
 	Bshift        uint8
 	Ashift        uint8
-	Pad0          [2]byte
-	Rmask         uint32
-	Gmask         uint32
-	Bmask         uint32
-	Amask         uint32
-	Colorkey      uint32
-	Alpha         uint8
-	Pad1          [3]byte
+	Refcount      int32
+	Next          *PixelFormat
+}
+
+type Point struct {
+	X int32
+	Y int32
 }
 
 type Rect struct {
-	X int16
-	Y int16
-	W uint16
-	H uint16
+	X int32
+	Y int32
+	W int32
+	H int32
+}
+
+type FPoint struct {
+	X float32
+	Y float32
+}
+
+type FRect struct {
+	X float32
+	Y float32
+	W float32
+	H float32
+}
+
+type DisplayMode struct {
+	Format      uint32
+	W           int32
+	H           int32
+	RefreshRate int32
+	DriverData  uintptr
 }
 
 type Color struct {
-	R      uint8
-	G      uint8
-	B      uint8
-	Alpha  uint8
-	Unused uint8
+	R     uint8
+	G     uint8
+	B     uint8
+	Alpha uint8
 }
 
 type Palette struct {
-	Ncolors int32
-	Colors  *Color
+	Ncolors  int32
+	Colors   *Color
+	Version  uint32
+	Refcount int32
 }
 
 type internalVideoInfo struct {
@@ -79,54 +105,153 @@ type KeyboardEvent struct {
 }
 
 type MouseMotionEvent struct {
-	Type  uint32
-	Which uint8
-	State uint8
-	Pad0  [1]byte
-	X     uint16
-	Y     uint16
-	Xrel  int16
-	Yrel  int16
+	Type      uint32
+	Timestamp uint32
+	WindowId  uint32
+	Which     uint32
+	State     uint32
+	X         int32
+	Y         int32
+	Xrel      int32
+	Yrel      int32
 }
 
 type MouseButtonEvent struct {
-	Type   uint32
-	Which  uint8
-	Button uint8
-	State  uint8
-	X      uint16
-	Y      uint16
+	Type      uint32
+	Timestamp uint32
+	WindowId  uint32
+	Which     uint32
+	Button    uint8
+	State     uint8
+	Clicks    uint8
+	Pad0      [1]byte
+	X         int32
+	Y         int32
+}
+
+type MouseWheelEvent struct {
+	Type      uint32
+	Timestamp uint32
+	WindowId  uint32
+	Which     uint32
+	X         int32 // Positive to the right
+	Y         int32 // Positive away from the user
+	Direction uint32
+	PreciseX  float32 // Fractional X, since SDL 2.0.18
+	PreciseY  float32 // Fractional Y, since SDL 2.0.18
+}
+
+type TouchFingerEvent struct {
+	Type      uint32
+	Timestamp uint32
+	TouchId   int64
+	FingerId  int64
+	X         float32 // Normalized to 0..1
+	Y         float32 // Normalized to 0..1
+	Dx        float32 // Normalized to -1..1
+	Dy        float32 // Normalized to -1..1
+	Pressure  float32
+	WindowId  uint32 // Since SDL 2.0.12
 }
 
 type JoyAxisEvent struct {
-	Type  uint8
-	Which uint8
-	Axis  uint8
-	Pad0  [1]byte
-	Value int16
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+	Axis      uint8
+	Pad0      [3]byte
+	Value     int16
+	Pad1      [2]byte
 }
 
 type JoyBallEvent struct {
-	Type  uint8
-	Which uint8
-	Ball  uint8
-	Pad0  [1]byte
-	Xrel  int16
-	Yrel  int16
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+	Ball      uint8
+	Pad0      [3]byte
+	Xrel      int16
+	Yrel      int16
 }
 
 type JoyHatEvent struct {
-	Type  uint8
-	Which uint8
-	Hat   uint8
-	Value uint8
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+	Hat       uint8
+	Value     uint8
+	Pad0      [2]byte
 }
 
 type JoyButtonEvent struct {
-	Type   uint8
-	Which  uint8
-	Button uint8
-	State  uint8
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+	Button    uint8
+	State     uint8
+	Pad0      [2]byte
+}
+
+type JoyDeviceEvent struct {
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+}
+
+type ControllerAxisEvent struct {
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+	Axis      uint8
+	Pad0      [3]byte
+	Value     int16
+	Pad1      [2]byte
+}
+
+type ControllerButtonEvent struct {
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+	Button    uint8
+	State     uint8
+	Pad0      [2]byte
+}
+
+type ControllerDeviceEvent struct {
+	Type      uint32
+	Timestamp uint32
+	Which     int32
+}
+
+type TextEditingEvent struct {
+	Type      uint32
+	Timestamp uint32
+	WindowId  uint32
+	Text      [32]byte // NUL-terminated UTF-8 composition
+	Start     int32    // Cursor position within the composition
+	Length    int32    // Length of the selection within the composition
+}
+
+type TextInputEvent struct {
+	Type      uint32
+	Timestamp uint32
+	WindowId  uint32
+	Text      [32]byte // NUL-terminated UTF-8 text
+}
+
+type RenderEvent struct {
+	Type      uint32
+	Timestamp uint32
+}
+
+type WindowEvent struct {
+	Type      uint32
+	Timestamp uint32
+	WindowId  uint32
+	Event     uint8
+	Pad0      [3]byte
+	Data1     int32
+	Data2     int32
 }
 
 type ResizeEvent struct {
@@ -162,13 +287,13 @@ type SysWMEvent struct {
 
 type Event struct {
 	Type uint32
-	Pad0 [31]byte
+	Pad0 [52]byte
 }
 
 type Keysym struct {
-	Scancode uint8
-	Pad0     [3]byte
+	Scancode Scancode
 	Sym      uint32
-	Mod      uint32
-	Unicode  uint16
+	Mod      uint16
+	Pad0     [2]byte
+	Unused   uint32
 }
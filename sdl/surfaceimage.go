@@ -0,0 +1,70 @@
+package sdl
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// A *Surface is a draw.Image, so it can be used with image/draw,
+// image/png and other Go imaging code. At and Set convert between the
+// surface's pixel format and non-premultiplied colors, locking RLE
+// surfaces as needed. They panic for formats of less than 8 bits per
+// pixel (PIXELFORMAT_INDEX1*, PIXELFORMAT_INDEX4*).
+var _ draw.Image = (*Surface)(nil)
+
+func (s *Surface) ColorModel() color.Model {
+	return color.NRGBAModel
+}
+
+func (s *Surface) Bounds() image.Rectangle {
+	return image.Rect(0, 0, int(s.W), int(s.H))
+}
+
+// Gets the color of the pixel at x,y, or transparent black outside the
+// surface.
+func (s *Surface) At(x, y int) color.Color {
+	s.check()
+
+	if x < 0 || y < 0 || x >= int(s.W) || y >= int(s.H) {
+		return color.NRGBA{}
+	}
+
+	r, g, b, a := unpackPixel(s.Format, s.GetPixel(x, y))
+	return color.NRGBA{r, g, b, a}
+}
+
+// Sets the color of the pixel at x,y. Points outside the surface are
+// ignored.
+func (s *Surface) Set(x, y int, c color.Color) {
+	s.check()
+
+	if x < 0 || y < 0 || x >= int(s.W) || y >= int(s.H) {
+		return
+	}
+
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	s.SetPixel(x, y, packPixel(s.Format, n.R, n.G, n.B, n.A))
+}
+
+// Creates a 32-bit RGBA surface with the contents of an image of any type.
+// The pixels of an *image.NRGBA, or of an opaque *image.RGBA, are shared
+// with the surface rather than copied, so changes to one show in the other.
+func CreateSurfaceFromImage(img image.Image) (*Surface, error) {
+	defer lockThread()()
+
+	b := img.Bounds()
+	if b.Empty() {
+		return nil, errEmptyImage
+	}
+
+	pix, stride := nrgbaPixels(img)
+
+	s := CreateRGBSurfaceWithFormatFrom(pix, b.Dx(), b.Dy(), 32, stride, PIXELFORMAT_RGBA32)
+	if s == nil {
+		return nil, errors.New(GetError())
+	}
+
+	return s, nil
+}
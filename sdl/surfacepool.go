@@ -0,0 +1,83 @@
+package sdl
+
+import "sync"
+
+type surfaceKey struct {
+	w, h   int
+	format uint32
+}
+
+// Hands out scratch surfaces and takes them back for reuse, so software
+// rendering code that needs temporary surfaces every frame doesn't create
+// and free them over and over.
+//
+// Surfaces are reused as they are: the pixels and settings (clip rect,
+// color key, blend mode, ...) left by the previous user are not reset.
+//
+// The zero value is an empty pool without a limit, ready to use.
+type SurfacePool struct {
+	// The number of released surfaces of each size and format to keep;
+	// extra surfaces are freed. 0 means no limit.
+	MaxIdle int
+
+	mutex sync.Mutex
+	idle  map[surfaceKey][]*Surface
+}
+
+func NewSurfacePool(maxIdle int) *SurfacePool {
+	return &SurfacePool{
+		MaxIdle: maxIdle,
+		idle:    make(map[surfaceKey][]*Surface),
+	}
+}
+
+// Gets a w x h surface in the format given by a PIXELFORMAT_* value,
+// reusing a released one if possible. Returns nil on error.
+func (p *SurfacePool) Get(w, h int, format uint32) *Surface {
+	key := surfaceKey{w, h, format}
+
+	p.mutex.Lock()
+	if free := p.idle[key]; len(free) > 0 {
+		s := free[len(free)-1]
+		free[len(free)-1] = nil
+		p.idle[key] = free[:len(free)-1]
+		p.mutex.Unlock()
+		return s
+	}
+	p.mutex.Unlock()
+
+	return CreateRGBSurfaceWithFormat(0, w, h, 0, format)
+}
+
+// Gives back a surface obtained from Get. The surface must not be used
+// after that.
+func (p *SurfacePool) Put(s *Surface) {
+	key := surfaceKey{int(s.W), int(s.H), s.Format.Format}
+
+	p.mutex.Lock()
+	if p.idle == nil {
+		p.idle = make(map[surfaceKey][]*Surface)
+	}
+	if p.MaxIdle == 0 || len(p.idle[key]) < p.MaxIdle {
+		p.idle[key] = append(p.idle[key], s)
+		p.mutex.Unlock()
+		return
+	}
+	p.mutex.Unlock()
+
+	s.Free()
+}
+
+// Frees all the released surfaces kept by the pool.
+func (p *SurfacePool) Clear() {
+	p.mutex.Lock()
+	idle := p.idle
+	p.idle = make(map[surfaceKey][]*Surface)
+	p.mutex.Unlock()
+
+	for _, free := range idle {
+		for _, s := range free {
+			s.Free()
+		}
+	}
+}
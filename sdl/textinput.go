@@ -0,0 +1,133 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "unicode/utf8"
+
+// ==========
+// Text input
+// ==========
+
+// Starts delivering TextInputEvents and TextEditingEvents, and shows the
+// on-screen keyboard or IME where the platform has one.
+func StartTextInput() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_StartTextInput()
+}
+
+// Stops delivering text input events.
+func StopTextInput() {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_StopTextInput()
+}
+
+// Checks whether text input events are enabled.
+func IsTextInputActive() bool {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	return C.SDL_IsTextInputActive() == C.SDL_TRUE
+}
+
+// Sets the area, in window coordinates, where text is being entered, so
+// the IME can place its candidate list next to it.
+func SetTextInputRect(rect *Rect) {
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	C.SDL_SetTextInputRect((*C.SDL_Rect)(cast(rect)))
+}
+
+// Returns the composition text.
+func (e TextEditingEvent) TextString() string {
+	return cText(e.Text[:])
+}
+
+// Returns the entered text.
+func (e TextInputEvent) TextString() string {
+	return cText(e.Text[:])
+}
+
+func cText(text []byte) string {
+	for i, b := range text {
+		if b == 0 {
+			return string(text[:i])
+		}
+	}
+	return string(text)
+}
+
+// Tracks the state of an input method editor for a text field: the text
+// being composed, and the position of the caret, which is passed on to the
+// IME so its candidate list follows the edited text.
+//
+// The text field reports its caret with SetCaret whenever it moves or is
+// redrawn; only changes are passed on to SDL.
+type IME struct {
+	// Text being composed, not yet part of the field's contents.
+	Composition string
+
+	// Cursor position and selection length within Composition, in
+	// characters.
+	Cursor, Selection int
+
+	caret  Rect
+	active bool
+}
+
+// Starts text input for the field whose caret is at the given rectangle.
+func (i *IME) Start(caret Rect) {
+	StartTextInput()
+	i.active = true
+
+	// Input rect changes are ignored while text input is stopped on some
+	// platforms, so it is always applied again
+	i.caret = caret
+	SetTextInputRect(&i.caret)
+}
+
+// Stops text input and discards the composition.
+func (i *IME) Stop() {
+	StopTextInput()
+	i.active = false
+	i.Composition, i.Cursor, i.Selection = "", 0, 0
+}
+
+// Reports the caret of the text field, in window coordinates; typically a
+// rectangle as tall as a line of text at the insertion point.
+func (i *IME) SetCaret(caret Rect) {
+	if !i.active || caret == i.caret {
+		return
+	}
+
+	i.caret = caret
+	SetTextInputRect(&i.caret)
+}
+
+// Updates the composition from TextEditingEvents and returns the text of
+// TextInputEvents, which should be inserted at the caret. Returns "" for
+// other events, so it is safe to pass every event received from
+// sdl.Events.
+func (i *IME) HandleEvent(event interface{}) string {
+	switch e := event.(type) {
+	case TextEditingEvent:
+		i.Composition = e.TextString()
+		i.Cursor = int(e.Start)
+		i.Selection = int(e.Length)
+
+		if n := utf8.RuneCountInString(i.Composition); i.Cursor > n {
+			i.Cursor = n
+		}
+
+	case TextInputEvent:
+		i.Composition, i.Cursor, i.Selection = "", 0, 0
+		return e.TextString()
+	}
+
+	return ""
+}
@@ -0,0 +1,131 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import "unsafe"
+
+// The memory of a locked texture area, sized to match the area exactly.
+//
+// Rows are Pitch bytes apart, which may be more than the width of a row in
+// bytes; use Row rather than indexing Pix with computed offsets.
+type TexturePixels struct {
+	Pix           []byte
+	Pitch         int
+	W             int // Width of the locked area, in pixels
+	H             int // Height of the locked area, in pixels
+	BytesPerPixel int
+}
+
+// Returns the pixels of row y, excluding any padding at the end of the row.
+func (p *TexturePixels) Row(y int) []byte {
+	start := y * p.Pitch
+	return p.Pix[start : start+p.W*p.BytesPerPixel]
+}
+
+// Returns the number of bytes used per pixel by a PIXELFORMAT_* value.
+// Planar YUV formats report the size of a luma sample.
+func bytesPerPixel(format uint32) int {
+	if format == 0 {
+		return 0
+	}
+
+	// FourCC formats
+	if (format>>28)&0x0F != 1 {
+		switch format {
+		case PIXELFORMAT_YUY2, PIXELFORMAT_UYVY, PIXELFORMAT_YVYU:
+			return 2
+		default:
+			return 1
+		}
+	}
+
+	return int(format & 0xFF)
+}
+
+// Locks an area of a streaming texture for write-only access, returning
+// its memory. The whole texture is locked if rect is nil.
+func (t *Texture) lock(rect *Rect) (*TexturePixels, error) {
+	defer lockThread()()
+
+	if err := t.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer t.mutex.Unlock()
+
+	var format C.Uint32
+	var w, h C.int
+	if C.SDL_QueryTexture(t.cTexture, &format, nil, &w, &h) != 0 {
+		return nil, sdlError()
+	}
+
+	if rect != nil {
+		w, h = C.int(rect.W), C.int(rect.H)
+	}
+
+	var pixels unsafe.Pointer
+	var pitch C.int
+	if C.SDL_LockTexture(t.cTexture, (*C.SDL_Rect)(cast(rect)), &pixels, &pitch) != 0 {
+		return nil, sdlError()
+	}
+
+	bpp := bytesPerPixel(uint32(format))
+
+	// The last row of a locked area is only guaranteed to be as wide as
+	// the area itself
+	size := 0
+	if h > 0 {
+		size = int(h-1)*int(pitch) + int(w)*bpp
+	}
+
+	return &TexturePixels{
+		Pix:           unsafe.Slice((*byte)(pixels), size),
+		Pitch:         int(pitch),
+		W:             int(w),
+		H:             int(h),
+		BytesPerPixel: bpp,
+	}, nil
+}
+
+// Locks an area of a streaming texture (the whole texture if rect is nil)
+// for write-only access, returning its memory and the number of bytes
+// between rows. The memory is only valid until Unlock is called, which
+// uploads the changes to the texture.
+func (t *Texture) Lock(rect *Rect) ([]byte, int, error) {
+	p, err := t.lock(rect)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return p.Pix, p.Pitch, nil
+}
+
+// Unlocks a texture locked with Lock, uploading the changes.
+func (t *Texture) Unlock() {
+	t.unlock()
+}
+
+func (t *Texture) unlock() {
+	t.acquire()
+	defer t.mutex.Unlock()
+
+	C.SDL_UnlockTexture(t.cTexture)
+}
+
+// Locks an area of a streaming texture (the whole texture if rect is nil),
+// calls f with its memory, and unlocks the texture again once f returns or
+// panics. The memory is write-only and must not be retained past f.
+func (t *Texture) WithLock(rect *Rect, f func(p *TexturePixels)) error {
+	p, err := t.lock(rect)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		p.Pix = nil
+		t.unlock()
+	}()
+
+	f(p)
+	return nil
+}
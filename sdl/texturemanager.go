@@ -0,0 +1,161 @@
+package sdl
+
+// #include <SDL2/SDL.h>
+import "C"
+
+import (
+	"errors"
+	"image"
+	"sync"
+)
+
+// Where a managed texture came from, so it can be created again.
+type textureSource struct {
+	surface *Surface
+	file    string
+	img     image.Image
+}
+
+func (s textureSource) create(r *Renderer) (*Texture, error) {
+	defer lockThread()()
+
+	switch {
+	case s.surface != nil:
+		t := CreateTextureFromSurface(r, s.surface)
+		if t == nil {
+			return nil, errors.New(GetError())
+		}
+		return t, nil
+	case s.img != nil:
+		return CreateTextureFromImage(r, s.img)
+	default:
+		return LoadTexture(r, s.file)
+	}
+}
+
+// Creates textures from surfaces, files and images, and recreates them
+// when the renderer loses them.
+//
+// Some renderers lose their textures when the graphics device is lost,
+// for instance when a Direct3D application goes full screen or the
+// display driver is updated, and report it with a RENDER_DEVICE_RESET or
+// RENDER_TARGETS_RESET event. Pass the events received from sdl.Events to
+// HandleEvent and the managed textures are recreated from their sources.
+// The *Texture values stay the same, as do their blend mode, alpha and
+// color modulation and scale mode.
+//
+// Surfaces and images given to the manager must stay unchanged (and
+// surfaces must not be freed) for as long as their texture is managed.
+type TextureManager struct {
+	renderer *Renderer
+	mutex    sync.Mutex
+	textures map[*Texture]textureSource
+}
+
+func NewTextureManager(r *Renderer) *TextureManager {
+	return &TextureManager{
+		renderer: r,
+		textures: make(map[*Texture]textureSource),
+	}
+}
+
+// Creates a managed texture with the contents of a surface.
+func (m *TextureManager) FromSurface(s *Surface) (*Texture, error) {
+	return m.add(textureSource{surface: s})
+}
+
+// Creates a managed texture from an image file, using SDL_image.
+func (m *TextureManager) FromFile(file string) (*Texture, error) {
+	return m.add(textureSource{file: file})
+}
+
+// Creates a managed texture with the contents of an image.
+func (m *TextureManager) FromImage(img image.Image) (*Texture, error) {
+	return m.add(textureSource{img: img})
+}
+
+func (m *TextureManager) add(src textureSource) (*Texture, error) {
+	t, err := src.create(m.renderer)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	m.textures[t] = src
+	m.mutex.Unlock()
+
+	return t, nil
+}
+
+// Stops managing the texture and destroys it.
+func (m *TextureManager) Release(t *Texture) {
+	m.mutex.Lock()
+	delete(m.textures, t)
+	m.mutex.Unlock()
+
+	t.Destroy()
+}
+
+// Recreates the managed textures after a RENDER_DEVICE_RESET or
+// RENDER_TARGETS_RESET event. Other events are ignored, so it is safe to
+// pass every event received from sdl.Events. Returns the first error met
+// while recreating the textures.
+func (m *TextureManager) HandleEvent(event interface{}) error {
+	e, ok := event.(RenderEvent)
+	if !ok || (e.Type != RENDER_DEVICE_RESET && e.Type != RENDER_TARGETS_RESET) {
+		return nil
+	}
+
+	return m.Reset()
+}
+
+// Recreates every managed texture from its source. Textures destroyed
+// without Release are forgotten. Returns the first error met; textures
+// that could not be recreated are kept as they were.
+func (m *TextureManager) Reset() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var firstErr error
+	for t, src := range m.textures {
+		if err := m.recreate(t, src); err != nil {
+			if errors.Is(err, ErrDestroyed) {
+				delete(m.textures, t)
+			} else if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// Creates the texture again from its source and swaps the new SDL texture
+// into t.
+func (m *TextureManager) recreate(t *Texture, src textureSource) error {
+	if err := t.tryAcquire(); err != nil {
+		return err
+	}
+	t.mutex.Unlock()
+
+	fresh, err := src.create(m.renderer)
+	if err != nil {
+		return err
+	}
+
+	fresh.SetBlendMode(t.GetBlendMode())
+	fresh.SetAlphaMod(t.GetAlphaMod())
+	fresh.SetColorMod(t.GetColorMod())
+	fresh.SetScaleMode(t.GetScaleMode())
+
+	if err := t.tryAcquire(); err != nil {
+		fresh.Destroy()
+		return err
+	}
+	defer t.mutex.Unlock()
+
+	C.SDL_DestroyTexture(t.cTexture)
+	t.cTexture = fresh.cTexture
+
+	return nil
+}
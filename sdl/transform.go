@@ -0,0 +1,156 @@
+package sdl
+
+import "math"
+
+// Creates an empty w x h surface in the same format as s, with the same
+// palette and color key.
+func (s *Surface) createLike(w, h int) *Surface {
+	d := CreateRGBSurfaceWithFormat(0, w, h, 0, s.Format.Format)
+	if d == nil {
+		return nil
+	}
+
+	if s.Format.Palette != nil {
+		d.SetPalette(s.Format.Palette)
+	}
+	if key, ok := s.GetColorKey(); ok {
+		d.SetColorKey(1, key)
+		d.FillRect(nil, key)
+	}
+
+	return d
+}
+
+// Creates a copy of the surface rotated by n quarter turns clockwise (a
+// negative n turns counterclockwise). Returns nil on error. Panics for
+// formats packing several pixels into a byte (PIXELFORMAT_INDEX1*,
+// PIXELFORMAT_INDEX4*).
+func (s *Surface) Rotate90(n int) *Surface {
+	s.check()
+	s.checkBytePixels()
+
+	n = ((n % 4) + 4) % 4
+	if n == 0 {
+		return s.Duplicate()
+	}
+
+	w, h := int(s.W), int(s.H)
+	dw, dh := w, h
+	if n != 2 {
+		dw, dh = h, w
+	}
+
+	d := s.createLike(dw, dh)
+	if d == nil {
+		return nil
+	}
+
+	bpp := int(s.Format.BytesPerPixel)
+	dpix, dpitch := d.pixelBytes(), int(d.Pitch)
+
+	s.withPixels(false, func(spix []byte) {
+		spitch := int(s.Pitch)
+		for dy := 0; dy < dh; dy++ {
+			for dx := 0; dx < dw; dx++ {
+				var sx, sy int
+				switch n {
+				case 1:
+					sx, sy = dy, h-1-dx
+				case 2:
+					sx, sy = w-1-dx, h-1-dy
+				case 3:
+					sx, sy = w-1-dy, dx
+				}
+				copy(dpix[dy*dpitch+dx*bpp:][:bpp], spix[sy*spitch+sx*bpp:])
+			}
+		}
+	})
+
+	return d
+}
+
+// Mirrors the surface in place, left to right if horizontal is set and
+// top to bottom if vertical is set. Panics for formats packing several
+// pixels into a byte (PIXELFORMAT_INDEX1*, PIXELFORMAT_INDEX4*).
+func (s *Surface) Flip(horizontal, vertical bool) {
+	s.check()
+	s.checkBytePixels()
+
+	w, h := int(s.W), int(s.H)
+	bpp, pitch := int(s.Format.BytesPerPixel), int(s.Pitch)
+	tmp := make([]byte, w*bpp)
+
+	s.withPixels(true, func(pix []byte) {
+		if vertical {
+			for y := 0; y < h/2; y++ {
+				top := pix[y*pitch:][:w*bpp]
+				bottom := pix[(h-1-y)*pitch:][:w*bpp]
+				copy(tmp, top)
+				copy(top, bottom)
+				copy(bottom, tmp)
+			}
+		}
+
+		if horizontal {
+			for y := 0; y < h; y++ {
+				row := pix[y*pitch:][:w*bpp]
+				for l, r := 0, w-1; l < r; l, r = l+1, r-1 {
+					copy(tmp[:bpp], row[l*bpp:])
+					copy(row[l*bpp:][:bpp], row[r*bpp:])
+					copy(row[r*bpp:][:bpp], tmp[:bpp])
+				}
+			}
+		}
+	})
+}
+
+// Creates a copy of the surface rotated counterclockwise by angle degrees
+// and scaled by zoom, using nearest neighbor sampling. The new surface is
+// just large enough to hold the result; the corners left uncovered are
+// filled with the color key if the surface has one, and with pixel value 0
+// otherwise: transparent for formats with alpha, black for RGB formats
+// without, and palette entry 0 for indexed formats. Returns nil on error.
+// Panics for formats packing several pixels into a byte
+// (PIXELFORMAT_INDEX1*, PIXELFORMAT_INDEX4*).
+func (s *Surface) RotoZoom(angle, zoom float64) *Surface {
+	s.check()
+	s.checkBytePixels()
+
+	if zoom <= 0 {
+		return nil
+	}
+
+	w, h := float64(s.W), float64(s.H)
+	sin, cos := math.Sincos(angle * math.Pi / 180)
+	dw := int(math.Ceil((w*math.Abs(cos) + h*math.Abs(sin)) * zoom))
+	dh := int(math.Ceil((w*math.Abs(sin) + h*math.Abs(cos)) * zoom))
+
+	d := s.createLike(dw, dh)
+	if d == nil {
+		return nil
+	}
+
+	bpp := int(s.Format.BytesPerPixel)
+	dpix, dpitch := d.pixelBytes(), int(d.Pitch)
+
+	s.withPixels(false, func(spix []byte) {
+		spitch := int(s.Pitch)
+		for dy := 0; dy < dh; dy++ {
+			for dx := 0; dx < dw; dx++ {
+				// Map the center of the destination pixel back into the
+				// source, relative to the centers of both surfaces
+				px := float64(dx) + 0.5 - float64(dw)/2
+				py := float64(dy) + 0.5 - float64(dh)/2
+				sx := int(math.Floor((px*cos-py*sin)/zoom + w/2))
+				sy := int(math.Floor((px*sin+py*cos)/zoom + h/2))
+
+				if sx < 0 || sy < 0 || sx >= int(s.W) || sy >= int(s.H) {
+					continue
+				}
+				copy(dpix[dy*dpitch+dx*bpp:][:bpp], spix[sy*spitch+sx*bpp:])
+			}
+		}
+	})
+
+	return d
+}
@@ -0,0 +1,117 @@
+package sdl
+
+// #include <stdint.h>
+// #include <SDL2/SDL.h>
+// #include <SDL2/SDL_syswm.h>
+//
+// static int getWMInfo(SDL_Window *window, int *subsystem, uintptr_t *handle, uintptr_t *display, uintptr_t *instance) {
+//	SDL_SysWMinfo info;
+//	SDL_VERSION(&info.version);
+//	if (!SDL_GetWindowWMInfo(window, &info)) {
+//		return -1;
+//	}
+//
+//	*subsystem = info.subsystem;
+//	*handle = *display = *instance = 0;
+//
+//	switch (info.subsystem) {
+//	#if defined(SDL_VIDEO_DRIVER_WINDOWS)
+//	case SDL_SYSWM_WINDOWS:
+//		*handle = (uintptr_t)info.info.win.window;
+//		*display = (uintptr_t)info.info.win.hdc;
+//		*instance = (uintptr_t)info.info.win.hinstance;
+//		break;
+//	#endif
+//	#if defined(SDL_VIDEO_DRIVER_X11)
+//	case SDL_SYSWM_X11:
+//		*handle = (uintptr_t)info.info.x11.window;
+//		*display = (uintptr_t)info.info.x11.display;
+//		break;
+//	#endif
+//	#if defined(SDL_VIDEO_DRIVER_COCOA)
+//	case SDL_SYSWM_COCOA:
+//		*handle = (uintptr_t)info.info.cocoa.window;
+//		break;
+//	#endif
+//	#if defined(SDL_VIDEO_DRIVER_UIKIT)
+//	case SDL_SYSWM_UIKIT:
+//		*handle = (uintptr_t)info.info.uikit.window;
+//		break;
+//	#endif
+//	#if defined(SDL_VIDEO_DRIVER_WAYLAND)
+//	case SDL_SYSWM_WAYLAND:
+//		*handle = (uintptr_t)info.info.wl.surface;
+//		*display = (uintptr_t)info.info.wl.display;
+//		break;
+//	#endif
+//	#if defined(SDL_VIDEO_DRIVER_ANDROID)
+//	case SDL_SYSWM_ANDROID:
+//		*handle = (uintptr_t)info.info.android.window;
+//		*instance = (uintptr_t)info.info.android.surface;
+//		break;
+//	#endif
+//	default:
+//		break;
+//	}
+//
+//	return 0;
+// }
+import "C"
+
+// Windowing subsystems reported by GetWMInfo
+const (
+	SYSWM_UNKNOWN  = C.SDL_SYSWM_UNKNOWN
+	SYSWM_WINDOWS  = C.SDL_SYSWM_WINDOWS
+	SYSWM_X11      = C.SDL_SYSWM_X11
+	SYSWM_DIRECTFB = C.SDL_SYSWM_DIRECTFB
+	SYSWM_COCOA    = C.SDL_SYSWM_COCOA
+	SYSWM_UIKIT    = C.SDL_SYSWM_UIKIT
+	SYSWM_WAYLAND  = C.SDL_SYSWM_WAYLAND
+	SYSWM_WINRT    = C.SDL_SYSWM_WINRT
+	SYSWM_ANDROID  = C.SDL_SYSWM_ANDROID
+	SYSWM_VIVANTE  = C.SDL_SYSWM_VIVANTE
+	SYSWM_OS2      = C.SDL_SYSWM_OS2
+	SYSWM_HAIKU    = C.SDL_SYSWM_HAIKU
+	SYSWM_KMSDRM   = C.SDL_SYSWM_KMSDRM
+)
+
+// Native handles of a window. Which fields are set depends on Subsystem:
+//
+//	SYSWM_WINDOWS  Window: HWND, Display: HDC, Instance: HINSTANCE
+//	SYSWM_X11      Window: X11 Window, Display: Display*
+//	SYSWM_COCOA    Window: NSWindow*
+//	SYSWM_UIKIT    Window: UIWindow*
+//	SYSWM_WAYLAND  Window: wl_surface*, Display: wl_display*
+//	SYSWM_ANDROID  Window: ANativeWindow*, Instance: EGLSurface
+//
+// Handles are plain numbers; converting them into pointers is up to the
+// code that passes them on to the native toolkit.
+type WMInfo struct {
+	Subsystem int
+	Window    uintptr
+	Display   uintptr
+	Instance  uintptr
+}
+
+// Gets the native handles of the window. Handles of subsystems the linked
+// SDL library was built without are left zero.
+func (w *Window) GetWMInfo() (*WMInfo, error) {
+	defer lockThread()()
+
+	GlobalMutex.Lock()
+	defer GlobalMutex.Unlock()
+
+	var subsystem C.int
+	var handle, display, instance C.uintptr_t
+
+	if C.getWMInfo(w.cWindow, &subsystem, &handle, &display, &instance) != 0 {
+		return nil, sdlError()
+	}
+
+	return &WMInfo{
+		Subsystem: int(subsystem),
+		Window:    uintptr(handle),
+		Display:   uintptr(display),
+		Instance:  uintptr(instance),
+	}, nil
+}
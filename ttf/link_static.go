@@ -0,0 +1,9 @@
+//go:build sdl_static
+
+package ttf
+
+// Links SDL2_ttf statically; see sdl/link_static.go.
+
+// #cgo linux LDFLAGS: -Wl,-Bstatic -lSDL2_ttf -lSDL2 -Wl,-Bdynamic -Wl,--as-needed
+// #cgo pkg-config: --static SDL2_ttf
+import "C"
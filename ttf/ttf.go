@@ -7,12 +7,11 @@ that work with loaded fonts are changed to have a more object-oriented feel.
 */
 package ttf
 
-// #cgo pkg-config: SDL_ttf
-// #include <SDL_ttf.h>
+// #include <SDL2/SDL_ttf.h>
 import "C"
 
 import (
-	"github.com/scottferg/Go-SDL/sdl"
+	"github.com/scottferg/Go-SDL2/sdl"
 	"sync"
 	"unsafe"
 )
@@ -29,6 +28,17 @@ func GoSdlVersion() string {
 	return "⚛SDL TTF bindings 1.0"
 }
 
+func init() {
+	v := C.TTF_Linked_Version()
+
+	sdl.RegisterLibrary(sdl.LibraryInfo{
+		Name:     "SDL2_ttf",
+		Linked:   true,
+		Compiled: sdl.Version{C.SDL_TTF_MAJOR_VERSION, C.SDL_TTF_MINOR_VERSION, C.SDL_TTF_PATCHLEVEL},
+		Runtime:  sdl.Version{uint8(v.major), uint8(v.minor), uint8(v.patch)},
+	})
+}
+
 func wrap(cSurface *C.SDL_Surface) *sdl.Surface {
 	var s *sdl.Surface
 
@@ -125,7 +135,7 @@ func RenderText_Solid(font *Font, text string, color sdl.Color) *sdl.Surface {
 	font.mutex.Lock()      // Use a write lock, because 'C.TTF_Render*' may update font's internal caches
 
 	ctext := C.CString(text)
-	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Unused)}
+	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Alpha)}
 	surface := C.TTF_RenderText_Solid(font.cfont, ctext, ccol)
 	C.free(unsafe.Pointer(ctext))
 
@@ -142,7 +152,7 @@ func RenderUTF8_Solid(font *Font, text string, color sdl.Color) *sdl.Surface {
 	font.mutex.Lock()      // Use a write lock, because 'C.TTF_Render*' may update font's internal caches
 
 	ctext := C.CString(text)
-	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Unused)}
+	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Alpha)}
 	surface := C.TTF_RenderUTF8_Solid(font.cfont, ctext, ccol)
 	C.free(unsafe.Pointer(ctext))
 
@@ -160,8 +170,8 @@ func RenderText_Shaded(font *Font, text string, color, bgcolor sdl.Color) *sdl.S
 	font.mutex.Lock()      // Use a write lock, because 'C.TTF_Render*' may update font's internal caches
 
 	ctext := C.CString(text)
-	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Unused)}
-	cbgcol := C.SDL_Color{C.Uint8(bgcolor.R), C.Uint8(bgcolor.G), C.Uint8(bgcolor.B), C.Uint8(bgcolor.Unused)}
+	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Alpha)}
+	cbgcol := C.SDL_Color{C.Uint8(bgcolor.R), C.Uint8(bgcolor.G), C.Uint8(bgcolor.B), C.Uint8(bgcolor.Alpha)}
 	surface := C.TTF_RenderText_Shaded(font.cfont, ctext, ccol, cbgcol)
 	C.free(unsafe.Pointer(ctext))
 
@@ -179,8 +189,8 @@ func RenderUTF8_Shaded(font *Font, text string, color, bgcolor sdl.Color) *sdl.S
 	font.mutex.Lock()      // Use a write lock, because 'C.TTF_Render*' may update font's internal caches
 
 	ctext := C.CString(text)
-	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Unused)}
-	cbgcol := C.SDL_Color{C.Uint8(bgcolor.R), C.Uint8(bgcolor.G), C.Uint8(bgcolor.B), C.Uint8(bgcolor.Unused)}
+	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Alpha)}
+	cbgcol := C.SDL_Color{C.Uint8(bgcolor.R), C.Uint8(bgcolor.G), C.Uint8(bgcolor.B), C.Uint8(bgcolor.Alpha)}
 	surface := C.TTF_RenderUTF8_Shaded(font.cfont, ctext, ccol, cbgcol)
 	C.free(unsafe.Pointer(ctext))
 
@@ -198,7 +208,7 @@ func RenderText_Blended(font *Font, text string, color sdl.Color) *sdl.Surface {
 	font.mutex.Lock()      // Use a write lock, because 'C.TTF_Render*' may update font's internal caches
 
 	ctext := C.CString(text)
-	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Unused)}
+	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Alpha)}
 	surface := C.TTF_RenderText_Blended(font.cfont, ctext, ccol)
 	C.free(unsafe.Pointer(ctext))
 
@@ -216,7 +226,7 @@ func RenderUTF8_Blended(font *Font, text string, color sdl.Color) *sdl.Surface {
 	font.mutex.Lock()      // Use a write lock, because 'C.TTF_Render*' may update font's internal caches
 
 	ctext := C.CString(text)
-	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Unused)}
+	ccol := C.SDL_Color{C.Uint8(color.R), C.Uint8(color.G), C.Uint8(color.B), C.Uint8(color.Alpha)}
 	surface := C.TTF_RenderUTF8_Blended(font.cfont, ctext, ccol)
 	C.free(unsafe.Pointer(ctext))
 